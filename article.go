@@ -0,0 +1,116 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// anPrefixes start with a consonant letter but a vowel sound
+var anPrefixes = []string{
+	"heir", "herb", "honest", "honor", "honour", "hour",
+}
+
+// aPrefixes start with a vowel letter but a consonant sound
+var aPrefixes = []string{
+	"eu", "ewe", "once", "ouija", "ubiq", "ufo", "uk", "unanim",
+	"unic", "unif", "unil", "unio", "uniq", "unis", "unit", "univ",
+	"ura", "ure", "uri", "uro", "usa", "use", "usu", "uten", "uti",
+}
+
+// vowelSoundLetters are the letters whose names start with a vowel sound,
+// used for acronyms read letter by letter: "an FBI agent"
+const vowelSoundLetters = "AEFHILMNORSX"
+
+//IndefiniteArticle returns "a" or "an" for the leading word of word,
+// going by its sound rather than its spelling:
+// "apple" -> "an", "user" -> "a", "hour" -> "an", "university" -> "a"
+// All uppercase words are read as letters, "an HTML page" but "a URL",
+// and numbers as they are spoken, "an 8" and "an 11" but "a 1".
+func (rs *Ruleset) IndefiniteArticle(word string) string {
+	fields := strings.Fields(word)
+	if len(fields) == 0 {
+		return "a"
+	}
+	first := strings.TrimLeftFunc(fields[0], func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if first == "" {
+		return "a"
+	}
+
+	r, _ := utf8.DecodeRuneInString(first)
+	if unicode.IsDigit(r) {
+		return numberArticle(first)
+	}
+	// accented vowels sound like their plain letter: "an école"
+	r, _ = utf8.DecodeRuneInString(stripLatinMarks(string(r)))
+	if isAllUpper(first) {
+		if strings.ContainsRune(vowelSoundLetters, r) {
+			return "an"
+		}
+		return "a"
+	}
+
+	lower := strings.ToLower(first)
+	for _, p := range anPrefixes {
+		if strings.HasPrefix(lower, p) {
+			return "an"
+		}
+	}
+	for _, p := range aPrefixes {
+		if strings.HasPrefix(lower, p) {
+			return "a"
+		}
+	}
+	if isOne(lower) {
+		return "a"
+	}
+	if strings.ContainsRune("aeiou", unicode.ToLower(r)) {
+		return "an"
+	}
+	return "a"
+}
+
+// isOne reports whether word is "one" on its own or leading a compound,
+// as in "one-time", but not "onerous"
+func isOne(word string) bool {
+	if !strings.HasPrefix(word, "one") {
+		return false
+	}
+	next, _ := utf8.DecodeRuneInString(word[len("one"):])
+	return len(word) == len("one") || !unicode.IsLetter(next)
+}
+
+// numberArticle picks the article for a word starting with digits, which
+// takes "an" when spoken as eight, eleven or eighteen of something
+func numberArticle(word string) string {
+	end := strings.IndexFunc(word, func(r rune) bool {
+		return !unicode.IsDigit(r) && r != ','
+	})
+	if end < 0 {
+		end = len(word)
+	}
+	digits := strings.Replace(word[:end], ",", "", -1)
+	if strings.HasPrefix(digits, "8") {
+		return "an"
+	}
+	if len(digits)%3 == 2 && (strings.HasPrefix(digits, "11") || strings.HasPrefix(digits, "18")) {
+		return "an"
+	}
+	return "a"
+}
+
+//Indefinite prefixes word with its indefinite article
+// "apple" -> "an apple", "user" -> "a user"
+func (rs *Ruleset) Indefinite(word string) string {
+	return rs.IndefiniteArticle(word) + " " + word
+}
+
+func IndefiniteArticle(word string) string {
+	return defaultRuleset.IndefiniteArticle(word)
+}
+
+func Indefinite(word string) string {
+	return defaultRuleset.Indefinite(word)
+}
@@ -0,0 +1,70 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IndefiniteArticle(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "apple", E: "an"},
+		{V: "user", E: "a"},
+		{V: "banana", E: "a"},
+		{V: "egg", E: "an"},
+		{V: "Orange", E: "an"},
+		{V: "hour", E: "an"},
+		{V: "honest mistake", E: "an"},
+		{V: "heir", E: "an"},
+		{V: "house", E: "a"},
+		{V: "university", E: "a"},
+		{V: "unicorn", E: "a"},
+		{V: "uniform", E: "a"},
+		{V: "union", E: "a"},
+		{V: "unique", E: "a"},
+		{V: "unit", E: "a"},
+		{V: "unimportant", E: "an"},
+		{V: "uninstall", E: "an"},
+		{V: "uninformed", E: "an"},
+		{V: "umbrella", E: "an"},
+		{V: "European", E: "a"},
+		{V: "one-time offer", E: "a"},
+		{V: "one", E: "a"},
+		{V: "onerous task", E: "an"},
+		{V: "école", E: "an"},
+		{V: "ÉCLAIR", E: "an"},
+		{V: "Über", E: "an"},
+		{V: "ñandu", E: "a"},
+		{V: "usual suspect", E: "a"},
+		{V: "FBI agent", E: "an"},
+		{V: "HTML page", E: "an"},
+		{V: "URL", E: "a"},
+		{V: "UFO", E: "a"},
+		{V: "X", E: "an"},
+		{V: "8", E: "an"},
+		{V: "80-year-old", E: "an"},
+		{V: "11", E: "an"},
+		{V: "18th century", E: "an"},
+		{V: "11,000", E: "an"},
+		{V: "1", E: "a"},
+		{V: "110", E: "a"},
+		{V: "\"apple\"", E: "an"},
+		{V: "", E: "a"},
+		{V: "   ", E: "a"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, IndefiniteArticle(tt.V), tt.V)
+	}
+}
+
+func Test_Indefinite(t *testing.T) {
+	r := require.New(t)
+	r.Equal("an apple", Indefinite("apple"))
+	r.Equal("a user", Indefinite("user"))
+	r.Equal("an hour", Indefinite("hour"))
+	r.Equal("a university", Indefinite("university"))
+}
@@ -0,0 +1,70 @@
+package inflect
+
+import "sync"
+
+// wordCache remembers the results of Pluralize and Singularize. Each
+// map is emptied once it holds size words, so memory stays bounded
+// without the bookkeeping of an LRU.
+type wordCache struct {
+	mu        sync.Mutex
+	size      int
+	plurals   map[string]string
+	singulars map[string]string
+}
+
+func newWordCache(size int) *wordCache {
+	c := &wordCache{size: size}
+	c.clear()
+	return c
+}
+
+func (c *wordCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.plurals = make(map[string]string)
+	c.singulars = make(map[string]string)
+}
+
+// lookup returns the cached result for word in m, computing and storing
+// it with fn on a miss. fn is called without holding the cache lock.
+func (c *wordCache) lookup(m *map[string]string, word string, fn func(string) string) string {
+	c.mu.Lock()
+	v, ok := (*m)[word]
+	c.mu.Unlock()
+	if ok {
+		return v
+	}
+	v = fn(word)
+	c.mu.Lock()
+	if len(*m) >= c.size {
+		*m = make(map[string]string)
+	}
+	(*m)[word] = v
+	c.mu.Unlock()
+	return v
+}
+
+// EnableCache caches the results of Pluralize and Singularize for up to
+// size words each, for workloads that transform the same words over and
+// over. The cache is emptied whenever rules change. A size of zero or
+// less disables the cache.
+func (rs *Ruleset) EnableCache(size int) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if size <= 0 {
+		rs.cache = nil
+		return
+	}
+	rs.cache = newWordCache(size)
+}
+
+// rulesChanged empties the cache; callers must hold rs.mu for writing
+func (rs *Ruleset) rulesChanged() {
+	if rs.cache != nil {
+		rs.cache.clear()
+	}
+}
+
+func EnableCache(size int) {
+	defaultRuleset.EnableCache(size)
+}
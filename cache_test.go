@@ -0,0 +1,86 @@
+package inflect
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableCache(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	rs.EnableCache(100)
+	r.Equal("people", rs.Pluralize("person"))
+	r.Equal("people", rs.Pluralize("person"))
+	r.Equal("person", rs.Singularize("people"))
+	r.Equal([]string{"boxes", "people"}, rs.PluralizeAll([]string{"box", "person"}))
+
+	rs.AddIrregular("person", "persons")
+	r.Equal("persons", rs.Pluralize("person"))
+	r.Equal("person", rs.Singularize("persons"))
+
+	rs.AddUncountable("box")
+	r.Equal("box", rs.Pluralize("box"))
+
+	rs.EnableCache(0)
+	r.Nil(rs.cache)
+	r.Equal("persons", rs.Pluralize("person"))
+}
+
+func TestEnableCacheStaysBounded(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	rs.EnableCache(2)
+	for _, w := range []string{"cat", "dog", "box", "fox", "cat"} {
+		rs.Pluralize(w)
+		r.True(len(rs.cache.plurals) <= 2)
+	}
+	r.Equal("cats", rs.Pluralize("cat"))
+}
+
+func TestEnableCacheConcurrent(t *testing.T) {
+	rs := NewDefaultRuleset()
+	rs.EnableCache(8)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				rs.Pluralize("person")
+				rs.Singularize("boxes")
+				rs.Pluralize("category")
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		rs.AddIrregular("cactus", "cacti")
+	}()
+	wg.Wait()
+	require.Equal(t, "cacti", rs.Pluralize("cactus"))
+}
+
+var cacheBenchWords = []string{
+	"person", "category", "status", "box", "child", "address", "query",
+	"matrix", "user", "index", "wolf", "mouse",
+}
+
+func BenchmarkPluralizeRepeated(b *testing.B) {
+	rs := NewDefaultRuleset()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rs.Pluralize(cacheBenchWords[i%len(cacheBenchWords)])
+	}
+}
+
+func BenchmarkPluralizeRepeatedCached(b *testing.B) {
+	rs := NewDefaultRuleset()
+	rs.EnableCache(len(cacheBenchWords))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rs.Pluralize(cacheBenchWords[i%len(cacheBenchWords)])
+	}
+}
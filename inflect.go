@@ -2,37 +2,100 @@ package inflect
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+	"gopkg.in/yaml.v2"
 )
 
 // baseAcronyms comes from https://en.wikipedia.org/wiki/List_of_information_technology_acronymss
-const baseAcronyms = `JSON,JWT,ID,UUID,SQL,ACK,ACL,ADSL,AES,ANSI,API,ARP,ATM,BGP,BSS,CAT,CCITT,CHAP,CIDR,CIR,CLI,CPE,CPU,CRC,CRT,CSMA,CMOS,DCE,DEC,DES,DHCP,DNS,DRAM,DSL,DSLAM,DTE,DMI,EHA,EIA,EIGRP,EOF,ESS,FCC,FCS,FDDI,FTP,GBIC,gbps,GEPOF,HDLC,HTTP,HTTPS,IANA,ICMP,IDF,IDS,IEEE,IETF,IMAP,IP,IPS,ISDN,ISP,kbps,LACP,LAN,LAPB,LAPF,LLC,MAC,MAN,Mbps,MC,MDF,MIB,MoCA,MPLS,MTU,NAC,NAT,NBMA,NIC,NRZ,NRZI,NVRAM,OSI,OSPF,OUI,PAP,PAT,PC,PIM,PIM,PCM,PDU,POP3,POP,POST,POTS,PPP,PPTP,PTT,PVST,RADIUS,RAM,RARP,RFC,RIP,RLL,ROM,RSTP,RTP,RCP,SDLC,SFD,SFP,SLARP,SLIP,SMTP,SNA,SNAP,SNMP,SOF,SRAM,SSH,SSID,STP,SYN,TDM,TFTP,TIA,TOFU,UDP,URL,URI,USB,UTP,VC,VLAN,VLSM,VPN,W3C,WAN,WEP,WiFi,WPA,WWW`
+const baseAcronyms = `JSON,JWT,ID,UUID,SQL,ACK,ACL,ADSL,AES,ANSI,API,ARP,ATM,BGP,BSS,CAT,CCITT,CHAP,CIDR,CIR,CLI,CPE,CPU,CRC,CRT,CSMA,CMOS,DCE,DEC,DES,DHCP,DNS,DRAM,DSL,DSLAM,DTE,DMI,EHA,EIA,EIGRP,EOF,ESS,FCC,FCS,FDDI,FTP,GBIC,GEPOF,HDLC,HTTP,HTTPS,IANA,ICMP,IDF,IDS,IEEE,IETF,IMAP,IP,IPS,ISDN,ISP,LACP,LAN,LAPB,LAPF,LLC,MAC,MAN,MC,MDF,MIB,MPLS,MTU,NAC,NAT,NBMA,NIC,NRZ,NRZI,NVRAM,OSI,OSPF,OUI,PAP,PAT,PC,PIM,PCM,PDU,POP3,POP,POST,POTS,PPP,PPTP,PTT,PVST,RADIUS,RAM,RARP,RFC,RIP,RLL,ROM,RSTP,RTP,RCP,SDLC,SFD,SFP,SLARP,SLIP,SMTP,SNA,SNAP,SNMP,SOF,SRAM,SSH,SSID,STP,SYN,TDM,TFTP,TIA,TOFU,UDP,URL,URI,USB,UTP,VC,VLAN,VLSM,VPN,W3C,WAN,WEP,WPA,WWW`
+
+// baseWordAcronyms are the baseAcronyms that are also ordinary English words
+var baseWordAcronyms = map[string]bool{
+	"CAT": true, "CHAP": true, "DEC": true, "MAC": true, "MAN": true,
+	"PAP": true, "PAT": true, "POP": true, "POST": true, "POTS": true,
+	"RADIUS": true, "RAM": true, "RIP": true, "SLIP": true, "SNAP": true,
+	"TOFU": true, "WAN": true,
+}
+
+// basePluralAcronyms are the baseAcronyms spelled like the plural of another
+// one, "IDS" and "ID" plus "s"
+var basePluralAcronyms = map[string]bool{"IDS": true, "IPS": true}
+
+// baseMixedCaseAcronyms are always displayed exactly as written here
+var baseMixedCaseAcronyms = []string{"Gbps", "kbps", "Mbps", "MoCA", "WiFi"}
 
 // Rule used by rulesets
 type Rule struct {
 	suffix      string
 	replacement string
 	exact       bool
+	// display is the exact casing of an acronym added with AddAcronymExact
+	display string
+	// word marks a default acronym that is also an ordinary English word,
+	// like "CAT" or "POST", which Titleize only keeps when already in caps
+	word bool
+	// plural marks a default acronym spelled like the plural of another,
+	// like "IDS", which is read as that plural unless written in caps
+	plural bool
+}
+
+// Suffix returns the suffix (or full word for exact rules) the rule matches
+func (r Rule) Suffix() string {
+	return r.suffix
+}
+
+// Replacement returns the string the matched suffix is replaced with
+func (r Rule) Replacement() string {
+	return r.replacement
+}
+
+// Exact reports whether the rule only matches the full word
+func (r Rule) Exact() bool {
+	return r.exact
 }
 
 // Ruleset a Ruleset is the config of pluralization rules
-// you can extend the rules with the Add* methods
+// you can extend the rules with the Add* methods.
+// Plural and singular rules are tried exact rules first, then longest
+// suffix first, and the most recently added rule wins a tie.
+// A Ruleset is safe for concurrent use by multiple goroutines.
 type Ruleset struct {
+	mu           sync.RWMutex
 	uncountables map[string]bool
 	plurals      []*Rule
 	singulars    []*Rule
 	humans       []*Rule
 	acronyms     []*Rule
+	// acronymIndex holds the acronyms by the first byte of their forms
+	acronymIndex map[byte][]*Rule
+	phrases      map[string]string
+	compounds    []string
+	passthroughs map[string]bool
+	cache        *wordCache
+	// spacers separate words when splitting; nil means isSpacerChar
+	spacers []rune
+	// preserveIDCasing stops "id" being treated as the acronym "ID"
+	preserveIDCasing bool
+	// fallbacks used when no rule matches; nil means the built-in behavior
+	defaultPlural   func(string) string
+	defaultSingular func(string) string
 }
 
 // NewRuleset creates a blank ruleset. Unless you are going to
@@ -46,12 +109,28 @@ func NewRuleset() *Ruleset {
 	rs.singulars = make([]*Rule, 0)
 	rs.humans = make([]*Rule, 0)
 	rs.acronyms = make([]*Rule, 0)
+	rs.phrases = make(map[string]string)
+	rs.passthroughs = make(map[string]bool)
 	return rs
 }
 
+// defaultTemplate is built once and cloned by NewDefaultRuleset, so the
+// hundreds of default rules are only added one at a time once per process
+var defaultTemplate struct {
+	once sync.Once
+	rs   *Ruleset
+}
+
 // NewDefaultRuleset creates a new ruleset and load it with the default
 // set of common English pluralization rules
 func NewDefaultRuleset() *Ruleset {
+	defaultTemplate.once.Do(func() {
+		defaultTemplate.rs = newDefaultRuleset()
+	})
+	return defaultTemplate.rs.Clone()
+}
+
+func newDefaultRuleset() *Ruleset {
 	rs := NewRuleset()
 	rs.AddPlural("s", "s")
 	rs.AddPlural("testis", "testes")
@@ -67,6 +146,13 @@ func NewDefaultRuleset() *Ruleset {
 	rs.AddPlural("bus", "buses")
 	rs.AddPlural("buffalo", "buffaloes")
 	rs.AddPlural("tomato", "tomatoes")
+	rs.AddPlural("hero", "heroes")
+	rs.AddPlural("potato", "potatoes")
+	rs.AddPlural("echo", "echoes")
+	rs.AddPlural("veto", "vetoes")
+	rs.AddPlural("photo", "photos")
+	rs.AddPlural("piano", "pianos")
+	rs.AddPlural("halo", "halos")
 	rs.AddPlural("tum", "ta")
 	rs.AddPlural("ium", "ia")
 	rs.AddPlural("ta", "ta")
@@ -141,6 +227,9 @@ func NewDefaultRuleset() *Ruleset {
 	rs.AddPluralExact("quiz", "quizzes", true)
 	rs.AddSingular("s", "")
 	rs.AddSingular("ss", "ss")
+	rs.AddSingular("sis", "sis")
+	rs.AddSingular("syllabus", "syllabus")
+	rs.AddSingular("genus", "genus")
 	rs.AddSingular("news", "news")
 	rs.AddSingular("ta", "tum")
 	rs.AddSingular("ia", "ium")
@@ -239,6 +328,8 @@ func NewDefaultRuleset() *Ruleset {
 	rs.AddSingular("vertices", "vertex")
 	rs.AddSingular("indices", "index")
 	rs.AddSingular("matrices", "matrix")
+	rs.AddPlural("schemata", "schemata")
+	rs.AddSingular("schemata", "schema")
 	rs.AddSingularExact("quizzes", "quiz", true)
 	rs.AddSingular("databases", "database")
 	rs.AddSingular("resses", "ress")
@@ -247,11 +338,21 @@ func NewDefaultRuleset() *Ruleset {
 	rs.AddIrregular("man", "men")
 	rs.AddIrregular("child", "children")
 	rs.AddIrregular("sex", "sexes")
+	rs.AddIrregular("genus", "genera")
 	rs.AddIrregular("move", "moves")
 	rs.AddIrregular("zombie", "zombies")
 	rs.AddIrregular("Status", "Statuses")
 	rs.AddIrregular("status", "statuses")
 	rs.AddIrregular("campus", "campuses")
+	rs.AddIrregular("focus", "foci")
+	rs.AddIrregular("genius", "geniuses")
+	rs.AddIrregular("slice", "slices")
+	rs.AddIrregular("cookie", "cookies")
+	rs.AddSingular("caches", "cache")
+	rs.AddSingular("curves", "curve")
+	rs.AddSingular("waves", "wave")
+	rs.AddSingular("faxes", "fax")
+	rs.AddSingular("taxes", "tax")
 	rs.AddUncountable("equipment")
 	rs.AddUncountable("information")
 	rs.AddUncountable("rice")
@@ -262,18 +363,188 @@ func NewDefaultRuleset() *Ruleset {
 	rs.AddUncountable("sheep")
 	rs.AddUncountable("jeans")
 	rs.AddUncountable("police")
-
-	acronyms := strings.Split(baseAcronyms, ",")
-	for _, acr := range acronyms {
-		rs.AddAcronym(acr)
+	rs.AddUncountable("aluminum")
+	rs.AddUncountable("data")
+	rs.AddUncountable("media")
+	rs.AddCompound("-in-law")
+	rs.AddCompound("-at-arms")
+	rs.AddCompound("-at-law")
+	rs.AddCompound("-in-chief")
+	rs.AddCompound("-in-waiting")
+	rs.AddCompound("-of-war")
+	rs.AddCompound("-general")
+	rs.AddIrregular("passer-by", "passers-by")
+	rs.AddPhrase("attorney general", "attorneys general")
+	rs.AddPhrase("mother-in-law", "mothers-in-law")
+	rs.AddPhrase("father-in-law", "fathers-in-law")
+	rs.AddPhrase("passerby", "passersby")
+
+	rs.AddAcronyms(strings.Split(baseAcronyms, ",")...)
+	for _, rule := range rs.acronyms {
+		rule.word = baseWordAcronyms[rule.suffix]
+		rule.plural = basePluralAcronyms[rule.suffix]
+	}
+	for _, acr := range baseMixedCaseAcronyms {
+		rs.AddAcronymExact(acr, acr)
 	}
 
 	return rs
 }
 
-// Uncountables returns a map of uncountables in the ruleset
+// NewDefaultRulesetBritish creates a new default ruleset with the forms
+// that differ in British English: "axes" -> "axe", "maths", "aluminium"
+func NewDefaultRulesetBritish() *Ruleset {
+	rs := NewDefaultRuleset()
+	rs.AddIrregular("axe", "axes")
+	rs.AddIrregular("moustache", "moustaches")
+	rs.AddUncountable("aluminium")
+	rs.AddUncountable("maths")
+	return rs
+}
+
+// Clone returns a deep copy of the ruleset. Rules added to the clone
+// do not affect the original ruleset and vice versa.
+func (rs *Ruleset) Clone() *Ruleset {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	c := NewRuleset()
+	for k, v := range rs.uncountables {
+		c.uncountables[k] = v
+	}
+	c.plurals = cloneRules(rs.plurals)
+	c.singulars = cloneRules(rs.singulars)
+	c.humans = cloneRules(rs.humans)
+	c.acronyms = cloneRules(rs.acronyms)
+	c.indexAcronyms()
+	for k, v := range rs.phrases {
+		c.phrases[k] = v
+	}
+	c.compounds = append(c.compounds, rs.compounds...)
+	for k, v := range rs.passthroughs {
+		c.passthroughs[k] = v
+	}
+	c.defaultPlural = rs.defaultPlural
+	if rs.cache != nil {
+		c.cache = newWordCache(rs.cache.size)
+	}
+	c.defaultSingular = rs.defaultSingular
+	c.preserveIDCasing = rs.preserveIDCasing
+	c.spacers = append([]rune(nil), rs.spacers...)
+	return c
+}
+
+func cloneRules(rules []*Rule) []*Rule {
+	c := make([]*Rule, len(rules))
+	for i, r := range rules {
+		cr := *r
+		c[i] = &cr
+	}
+	return c
+}
+
+// Uncountables returns a copy of the map of uncountables in the ruleset
 func (rs *Ruleset) Uncountables() map[string]bool {
-	return rs.uncountables
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	m := make(map[string]bool, len(rs.uncountables))
+	for k, v := range rs.uncountables {
+		m[k] = v
+	}
+	return m
+}
+
+// Plurals returns a copy of the pluralization rules in the order they are applied
+func (rs *Ruleset) Plurals() []Rule {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return copyRules(rs.plurals)
+}
+
+// Singulars returns a copy of the singularization rules in the order they are applied
+func (rs *Ruleset) Singulars() []Rule {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return copyRules(rs.singulars)
+}
+
+// Humans returns a copy of the human rules in the order they are applied
+func (rs *Ruleset) Humans() []Rule {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return copyRules(rs.humans)
+}
+
+// AcronymRules returns a copy of the acronym rules in the order they are applied
+func (rs *Ruleset) AcronymRules() []Rule {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return copyRules(rs.acronyms)
+}
+
+// Acronyms returns a map of every registered acronym to the casing it is
+// displayed in, like "API" -> "API" and "oauth" -> "OAuth"
+func (rs *Ruleset) Acronyms() map[string]string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	m := make(map[string]string, len(rs.acronyms))
+	for _, rule := range rs.acronyms {
+		if _, ok := m[rule.suffix]; !ok {
+			m[rule.suffix] = acronymDisplay(rule)
+		}
+	}
+	return m
+}
+
+func copyRules(rules []*Rule) []Rule {
+	c := make([]Rule, len(rules))
+	for i, r := range rules {
+		c[i] = *r
+	}
+	return c
+}
+
+// SetDefaultPluralFunc sets the function Pluralize falls back to when
+// no rule matches. By default "s" is appended, passing nil restores that.
+func (rs *Ruleset) SetDefaultPluralFunc(fn func(string) string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	rs.defaultPlural = fn
+}
+
+// SetDefaultSingularFunc sets the function Singularize falls back to when
+// no rule matches. By default the word is returned unchanged, passing nil
+// restores that.
+func (rs *Ruleset) SetDefaultSingularFunc(fn func(string) string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	rs.defaultSingular = fn
+}
+
+// SetSpacers sets the characters that separate words for every function
+// that splits its input into words, such as Camelize, Underscore, Titleize
+// and SplitWords. The default is "_", " ", ":" and "-"; calling SetSpacers
+// with no runes restores it. With '.' added, Camelize("a.b.c") is "ABC".
+func (rs *Ruleset) SetSpacers(runes ...rune) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	if len(runes) == 0 {
+		rs.spacers = nil
+		return
+	}
+	rs.spacers = append([]rune(nil), runes...)
+}
+
+// SetPreserveIDCasing stops "id" being cased as the acronym "ID" by
+// Capitalize, Camelize, Titleize and the other acronym aware methods when
+// preserve is true, so Capitalize("id") is "Id". It is false by default.
+func (rs *Ruleset) SetPreserveIDCasing(preserve bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	rs.preserveIDCasing = preserve
 }
 
 // AddPlural add a pluralization rule
@@ -283,6 +554,13 @@ func (rs *Ruleset) AddPlural(suffix, replacement string) {
 
 // AddPluralExact add a pluralization rule with full string match
 func (rs *Ruleset) AddPluralExact(suffix, replacement string, exact bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	rs.addPluralExact(suffix, replacement, exact)
+}
+
+func (rs *Ruleset) addPluralExact(suffix, replacement string, exact bool) {
 	// remove uncountable
 	delete(rs.uncountables, suffix)
 	// create rule
@@ -290,8 +568,7 @@ func (rs *Ruleset) AddPluralExact(suffix, replacement string, exact bool) {
 	r.suffix = suffix
 	r.replacement = replacement
 	r.exact = exact
-	// prepend
-	rs.plurals = append([]*Rule{r}, rs.plurals...)
+	rs.plurals = insertRule(rs.plurals, r)
 }
 
 // AddSingular add a singular rule
@@ -302,6 +579,13 @@ func (rs *Ruleset) AddSingular(suffix, replacement string) {
 // AddSingularExact same as AddSingular but you can set `exact` to force
 // a full string match
 func (rs *Ruleset) AddSingularExact(suffix, replacement string, exact bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	rs.addSingularExact(suffix, replacement, exact)
+}
+
+func (rs *Ruleset) addSingularExact(suffix, replacement string, exact bool) {
 	// remove from uncountable
 	delete(rs.uncountables, suffix)
 	// create rule
@@ -309,12 +593,35 @@ func (rs *Ruleset) AddSingularExact(suffix, replacement string, exact bool) {
 	r.suffix = suffix
 	r.replacement = replacement
 	r.exact = exact
-	rs.singulars = append([]*Rule{r}, rs.singulars...)
+	rs.singulars = insertRule(rs.singulars, r)
+}
+
+// insertRule adds r to rules, which are kept in the order they are tried:
+// exact rules first, then longer suffixes before shorter ones, and among
+// rules of the same kind and length the most recently added first
+func insertRule(rules []*Rule, r *Rule) []*Rule {
+	i := sort.Search(len(rules), func(i int) bool {
+		return !outranks(rules[i], r)
+	})
+	rules = append(rules, nil)
+	copy(rules[i+1:], rules[i:])
+	rules[i] = r
+	return rules
+}
+
+// outranks reports whether a is tried before b regardless of which was added last
+func outranks(a, b *Rule) bool {
+	if a.exact != b.exact {
+		return a.exact
+	}
+	return len(a.suffix) > len(b.suffix)
 }
 
 // AddHuman Human rules are applied by humanize to show more friendly
 // versions of words
 func (rs *Ruleset) AddHuman(suffix, replacement string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
 	r := new(Rule)
 	r.suffix = suffix
 	r.replacement = replacement
@@ -324,29 +631,268 @@ func (rs *Ruleset) AddHuman(suffix, replacement string) {
 // AddIrregular Add any inconsistent pluralizing/singularizing rules
 // to the set here.
 func (rs *Ruleset) AddIrregular(singular, plural string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
 	delete(rs.uncountables, singular)
 	delete(rs.uncountables, plural)
-	rs.AddPlural(singular, plural)
-	rs.AddPlural(plural, plural)
-	rs.AddSingular(plural, singular)
+	rs.addPluralExact(singular, plural, false)
+	rs.addPluralExact(plural, plural, false)
+	rs.addSingularExact(plural, singular, false)
+}
+
+// AddIrregulars adds every singular -> plural pair like AddIrregular, in
+// the sorted order of the singulars. The rules are added together and
+// ordered once, so loading a large dictionary is not quadratic.
+func (rs *Ruleset) AddIrregulars(pairs map[string]string) {
+	singulars := make([]string, 0, len(pairs))
+	for singular := range pairs {
+		singulars = append(singulars, singular)
+	}
+	sort.Strings(singulars)
+
+	// newest first, as insertRule would leave them
+	plurals := make([]*Rule, 0, 2*len(pairs))
+	singularRules := make([]*Rule, 0, len(pairs))
+	for i := len(singulars) - 1; i >= 0; i-- {
+		singular, plural := singulars[i], pairs[singulars[i]]
+		plurals = append(plurals, &Rule{suffix: plural, replacement: plural}, &Rule{suffix: singular, replacement: plural})
+		singularRules = append(singularRules, &Rule{suffix: plural, replacement: singular})
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	for singular, plural := range pairs {
+		delete(rs.uncountables, singular)
+		delete(rs.uncountables, plural)
+	}
+	rs.plurals = mergeRules(plurals, rs.plurals)
+	rs.singulars = mergeRules(singularRules, rs.singulars)
+}
+
+// mergeRules puts added, newest first, ahead of rules and restores the
+// order insertRule keeps with a single stable sort
+func mergeRules(added, rules []*Rule) []*Rule {
+	merged := append(added, rules...)
+	sort.SliceStable(merged, func(i, j int) bool {
+		return outranks(merged[i], merged[j])
+	})
+	return merged
+}
+
+// AddPhrase registers the plural of a phrase whose head noun is not its
+// last word, for use by PluralizePhrase: "attorney general" -> "attorneys general"
+func (rs *Ruleset) AddPhrase(singular, plural string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.phrases[singular] = plural
+}
+
+// AddCompound registers the tail of hyphenated compounds whose first word
+// is the one that changes: after AddCompound("-in-law"), "mother-in-law"
+// pluralizes to "mothers-in-law"
+func (rs *Ruleset) AddCompound(tail string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	rs.compounds = append(rs.compounds, strings.ToLower(tail))
+}
+
+// compoundHead splits word into the head noun and the tail of a
+// registered compound
+func (rs *Ruleset) compoundHead(word string) (string, string, bool) {
+	lWord := strings.ToLower(word)
+	for _, tail := range rs.compounds {
+		if len(lWord) > len(tail) && strings.HasSuffix(lWord, tail) {
+			i := len(word) - len(tail)
+			return word[:i], word[i:], true
+		}
+	}
+	return "", "", false
+}
+
+// AddScientificPlurals treats "data" and "media" as the plurals of
+// "datum" and "medium" instead of as uncountable words
+func (rs *Ruleset) AddScientificPlurals() {
+	rs.AddIrregular("datum", "data")
+	rs.AddIrregular("medium", "media")
 }
 
 // AddAcronym if you use acronym you may need to add them to the ruleset
 // to prevent Underscored words of things like "HTML" coming out
 // as "h_t_m_l"
 func (rs *Ruleset) AddAcronym(word string) {
+	rs.AddAcronymExact(word, "")
+}
+
+// AddAcronyms adds each of words as an acronym, see AddAcronym
+func (rs *Ruleset) AddAcronyms(words ...string) {
+	for _, w := range words {
+		rs.AddAcronym(w)
+	}
+}
+
+// AddAcronymsFromReader adds the comma or newline separated acronyms read
+// from r. Surrounding whitespace and empty entries are ignored.
+func (rs *Ruleset) AddAcronymsFromReader(r io.Reader) error {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("could not read acronyms from reader: %s", err)
+	}
+	words := strings.FieldsFunc(string(b), func(c rune) bool {
+		return c == ',' || c == '\n'
+	})
+	for _, w := range words {
+		if w = strings.TrimSpace(w); w != "" {
+			rs.AddAcronym(w)
+		}
+	}
+	return nil
+}
+
+// AddAcronymExact same as AddAcronym but the acronym is always displayed
+// as given, for mixed case acronyms like "OAuth" or "IPv6"
+func (rs *Ruleset) AddAcronymExact(word, display string) {
 	r := new(Rule)
 	r.suffix = word
-	r.replacement = rs.Titleize(strings.ToLower(word))
+	r.replacement = upperFirst(strings.ToLower(word))
+	r.display = display
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	// adding an acronym again, in any casing, replaces the earlier rule
+	defer rs.indexAcronyms()
+	for i, rule := range rs.acronyms {
+		if strings.EqualFold(rule.suffix, word) {
+			rs.acronyms[i] = r
+			return
+		}
+	}
 	rs.acronyms = append(rs.acronyms, r)
 }
 
 // AddUncountable add a word to this ruleset that has the same singular and plural form
 // for example: "rice"
 func (rs *Ruleset) AddUncountable(word string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
 	rs.uncountables[strings.ToLower(word)] = true
 }
 
+// RemovePlural removes all pluralization rules with the given suffix.
+// It reports whether any rule was removed.
+func (rs *Ruleset) RemovePlural(suffix string) bool {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	var removed bool
+	rs.plurals, removed = removeRules(rs.plurals, suffix)
+	return removed
+}
+
+// RemoveSingular removes all singularization rules with the given suffix.
+// It reports whether any rule was removed.
+func (rs *Ruleset) RemoveSingular(suffix string) bool {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	var removed bool
+	rs.singulars, removed = removeRules(rs.singulars, suffix)
+	return removed
+}
+
+// RemoveUncountable removes a word from the uncountables of this ruleset
+func (rs *Ruleset) RemoveUncountable(word string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	delete(rs.uncountables, strings.ToLower(word))
+}
+
+// RemoveAcronym removes an acronym from this ruleset
+func (rs *Ruleset) RemoveAcronym(word string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	acronyms := rs.acronyms[:0]
+	for _, rule := range rs.acronyms {
+		if !strings.EqualFold(rule.suffix, word) {
+			acronyms = append(acronyms, rule)
+		}
+	}
+	rs.acronyms = acronyms
+	rs.indexAcronyms()
+}
+
+// indexAcronyms rebuilds acronymIndex, it must be called with the
+// lock held whenever the acronyms change
+func (rs *Ruleset) indexAcronyms() {
+	rs.acronymIndex = make(map[byte][]*Rule)
+	for _, rule := range rs.acronyms {
+		if rule.suffix != "" {
+			rs.acronymIndex[rule.suffix[0]] = append(rs.acronymIndex[rule.suffix[0]], rule)
+		}
+		if rule.display != "" && (rule.suffix == "" || rule.display[0] != rule.suffix[0]) {
+			rs.acronymIndex[rule.display[0]] = append(rs.acronymIndex[rule.display[0]], rule)
+		}
+	}
+}
+
+func removeRules(rules []*Rule, suffix string) ([]*Rule, bool) {
+	kept := make([]*Rule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.suffix != suffix {
+			kept = append(kept, rule)
+		}
+	}
+	return kept, len(kept) != len(rules)
+}
+
+// AddPassthrough registers a literal word, like the product code "X11",
+// that every transformation returns exactly as-is when it is the whole
+// input: Pluralize, Singularize, Camelize, Underscore, Titleize and the rest.
+// Unlike an uncountable, which is only left alone by Pluralize and
+// Singularize, a passthrough is matched case sensitively.
+func (rs *Ruleset) AddPassthrough(word string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	rs.passthroughs[word] = true
+}
+
+// IsPassthrough reports whether word was registered with AddPassthrough
+func (rs *Ruleset) IsPassthrough(word string) bool {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.passthroughs[word]
+}
+
+// IsUncountable reports whether the last word of word is uncountable:
+// "fish" and "school fish" are, "fish schools" is not
+func (rs *Ruleset) IsUncountable(word string) bool {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.isUncountable(word)
+}
+
+// IsPlural reports whether word is a plural, that is whether Singularize
+// changes it. Uncountable words are both plural and singular.
+func (rs *Ruleset) IsPlural(word string) bool {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.isUncountable(word) || rs.singularize(word) != word
+}
+
+// IsSingular reports whether word is a singular, that is whether Singularize
+// leaves it unchanged. Uncountable words are both plural and singular.
+func (rs *Ruleset) IsSingular(word string) bool {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.isUncountable(word) || rs.singularize(word) == word
+}
+
 func (rs *Ruleset) isUncountable(word string) bool {
 	// handle multiple words by using the last one
 	words := strings.Split(word, " ")
@@ -358,13 +904,47 @@ func (rs *Ruleset) isUncountable(word string) bool {
 
 //isAcronym returns if a word is acronym or not.
 func (rs *Ruleset) isAcronym(word string) bool {
+	_, ok := rs.acronym(word)
+	return ok
+}
+
+// acronym returns the canonical casing of word if it is a registered
+// acronym, acronyms registered in lowercase are uppercased
+func (rs *Ruleset) acronym(word string) (string, bool) {
+	if rule := rs.acronymRule(word); rule != nil {
+		return acronymDisplay(rule), true
+	}
+	return "", false
+}
+
+// acronymRule returns the acronym rule matching word in any casing
+func (rs *Ruleset) acronymRule(word string) *Rule {
 	for _, rule := range rs.acronyms {
-		if strings.ToUpper(rule.suffix) == strings.ToUpper(word) {
-			return true
+		if rs.isPreservedID(rule) {
+			continue
+		}
+		if strings.ToUpper(rule.suffix) == strings.ToUpper(word) || (rule.display != "" && strings.EqualFold(rule.display, word)) {
+			return rule
 		}
 	}
+	return nil
+}
 
-	return false
+// isPreservedID reports whether rule is the "ID" acronym and
+// SetPreserveIDCasing has turned it off
+func (rs *Ruleset) isPreservedID(rule *Rule) bool {
+	return rs.preserveIDCasing && strings.EqualFold(rule.suffix, "id")
+}
+
+// acronymDisplay returns the casing an acronym rule is shown in
+func acronymDisplay(rule *Rule) string {
+	if rule.display != "" {
+		return rule.display
+	}
+	if rule.suffix == strings.ToLower(rule.suffix) {
+		return strings.ToUpper(rule.suffix)
+	}
+	return rule.suffix
 }
 
 //PluralizeWithSize pluralize with taking number into account
@@ -375,238 +955,1343 @@ func (rs *Ruleset) PluralizeWithSize(word string, size int) string {
 	return rs.Pluralize(word)
 }
 
-// Pluralize returns the plural form of a singular word
-func (rs *Ruleset) Pluralize(word string) string {
-	if len(word) == 0 {
-		return word
-	}
-	lWord := strings.ToLower(word)
-	if rs.isUncountable(lWord) {
-		return word
-	}
+//PluralizeWithCount prefixes the count to the word, pluralized unless
+// count is exactly 1: (2, "person") -> "2 people"
+func (rs *Ruleset) PluralizeWithCount(count int, word string) string {
+	return strconv.Itoa(count) + " " + rs.PluralizeWithSize(word, count)
+}
 
-	var candidate string
-	for _, rule := range rs.plurals {
-		if rule.exact {
-			if lWord == rule.suffix {
-				// Capitalized word
-				if lWord[0] != word[0] && lWord[1:] == word[1:] {
-					return rs.Capitalize(rule.replacement)
-				}
-				return rule.replacement
-			}
-			continue
-		}
+// countAbbreviations are the suffixes HumanizeCount uses, largest first
+var countAbbreviations = []struct {
+	size   int
+	suffix string
+}{
+	{1000000000, "B"},
+	{1000000, "M"},
+	{1000, "k"},
+}
 
-		if strings.EqualFold(word, rule.suffix) {
-			candidate = rule.replacement
+//HumanizeCount same as PluralizeWithCount but counts of a thousand or more
+// are abbreviated with "k", a million or more with "M" and a billion or
+// more with "B", keeping one decimal: (1500, "comment") -> "1.5k comments"
+// The decimal is truncated rather than rounded, so 1999 is "1.9k" and an
+// abbreviation never overstates the count.
+func (rs *Ruleset) HumanizeCount(count int, word string) string {
+	sign := ""
+	if count < 0 {
+		sign = "-"
+	}
+	for _, a := range countAbbreviations {
+		if count < a.size && count > -a.size {
+			continue
 		}
-
-		if strings.HasSuffix(word, rule.suffix) {
-			return replaceLast(word, rule.suffix, rule.replacement)
+		tenths := abs(count / (a.size / 10))
+		number := strconv.Itoa(tenths / 10)
+		if tenths%10 != 0 {
+			number += "." + strconv.Itoa(tenths%10)
 		}
+		return sign + number + a.suffix + " " + rs.Pluralize(word)
 	}
+	return rs.PluralizeWithCount(count, word)
+}
 
-	if candidate != "" {
-		return candidate
-	}
-	return word + "s"
+// PossessiveStyle selects how PossessiveWithStyle treats singular nouns
+// ending in "s"
+type PossessiveStyle int
+
+const (
+	// PossessiveApostropheS adds "'s" to every singular noun: "James's"
+	PossessiveApostropheS PossessiveStyle = iota
+	// PossessiveApostrophe adds only "'" to singular nouns ending in "s": "James'"
+	PossessiveApostrophe
+)
+
+//Possessive the possessive form of word, of its plural when plural is
+// true: ("child", false) -> "child's", ("child", true) -> "children's",
+// ("dog", true) -> "dogs'". A possessive ending already on word is dropped
+// first, so "child's" works too. Singular nouns ending in "s" get "'s",
+// see PossessiveWithStyle for "James'".
+func (rs *Ruleset) Possessive(word string, plural bool) string {
+	return rs.PossessiveWithStyle(word, plural, PossessiveApostropheS)
 }
 
-//Singularize returns the singular form of a plural word
-func (rs *Ruleset) Singularize(word string) string {
-	if len(word) <= 1 {
+//PossessiveWithStyle same as Possessive with the given style for
+// singular nouns ending in "s": ("James", false, PossessiveApostrophe) -> "James'"
+func (rs *Ruleset) PossessiveWithStyle(word string, plural bool, style PossessiveStyle) string {
+	if word == "" {
 		return word
 	}
-	lWord := strings.ToLower(word)
-	if rs.isUncountable(lWord) {
-		return word
+	noun := strings.TrimSuffix(word, "'s")
+	if noun == word {
+		noun = strings.TrimSuffix(word, "'")
 	}
-
-	var candidate string
-
-	for _, rule := range rs.singulars {
-		if rule.exact {
-			if lWord == rule.suffix {
-				// Capitalized word
-				if lWord[0] != word[0] && lWord[1:] == word[1:] {
-					return rs.Capitalize(rule.replacement)
-				}
-				return rule.replacement
-			}
-			continue
-		}
-
-		if strings.EqualFold(word, rule.suffix) {
-			candidate = rule.replacement
-		}
-
-		if strings.HasSuffix(word, rule.suffix) {
-			return replaceLast(word, rule.suffix, rule.replacement)
-		}
+	if plural {
+		noun = rs.Pluralize(noun)
 	}
-
-	if candidate != "" {
-		return candidate
+	if strings.HasSuffix(strings.ToLower(noun), "s") && (plural || style == PossessiveApostrophe) {
+		return noun + "'"
 	}
-
-	return word
+	return noun + "'s"
 }
 
-//Capitalize uppercase first character
-func (rs *Ruleset) Capitalize(word string) string {
-	if rs.isAcronym(word) {
-		return strings.ToUpper(word)
+//PluralizePhrase pluralizes a phrase registered with AddPhrase, otherwise
+// its last word: "box of chocolate" -> "box of chocolates"
+func (rs *Ruleset) PluralizePhrase(phrase string) string {
+	rs.mu.RLock()
+	plural, ok := rs.phrases[phrase]
+	rs.mu.RUnlock()
+	if ok {
+		return plural
 	}
-	return strings.ToUpper(word[:1]) + word[1:]
+	i := strings.LastIndex(phrase, " ") + 1
+	return phrase[:i] + rs.Pluralize(phrase[i:])
 }
 
-//Camelize "dino_party" -> "DinoParty"
-func (rs *Ruleset) Camelize(word string) string {
-	if rs.isAcronym(word) {
-		return strings.ToUpper(word)
+//PluralizeAll returns a new slice with every word pluralized, nil for nil
+func (rs *Ruleset) PluralizeAll(words []string) []string {
+	if words == nil {
+		return nil
 	}
-	words := splitAtCaseChangeWithTitlecase(word)
-	return strings.Join(words, "")
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	plurals := make([]string, len(words))
+	for i, w := range words {
+		plurals[i] = rs.pluralize(w)
+	}
+	return plurals
 }
 
-//CamelizeDownFirst same as Camelcase but with first letter downcased
-func (rs *Ruleset) CamelizeDownFirst(word string) string {
-	word = Camelize(word)
-	return strings.ToLower(word[:1]) + word[1:]
+//SingularizeAll returns a new slice with every word singularized, nil for nil
+func (rs *Ruleset) SingularizeAll(words []string) []string {
+	if words == nil {
+		return nil
+	}
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	singulars := make([]string, len(words))
+	for i, w := range words {
+		singulars[i] = rs.singularize(w)
+	}
+	return singulars
 }
 
-//Titleize Capitalize every word in sentence "hello there" -> "Hello There"
-func (rs *Ruleset) Titleize(word string) string {
-	words := splitAtCaseChangeWithTitlecase(word)
-	result := strings.Join(words, " ")
+// contextCheckInterval is how many words the Context variants transform
+// between checks of ctx.Err()
+const contextCheckInterval = 64
 
-	var acronymWords []string
-	for index, word := range words {
-		if len(word) == 1 {
-			acronymWords = append(acronymWords, word)
-		}
+//PluralizeAllContext same as PluralizeAll but stops early once ctx is done,
+// returning the words pluralized so far and ctx.Err()
+func (rs *Ruleset) PluralizeAllContext(ctx context.Context, words []string) ([]string, error) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return transformAllContext(ctx, words, rs.pluralize)
+}
 
-		if len(word) > 1 || index == len(words)-1 || len(acronymWords) > 1 {
-			acronym := strings.Join(acronymWords, "")
-			if !rs.isAcronym(acronym) {
-				acronymWords = acronymWords[:len(acronymWords)]
-				continue
-			}
+//SingularizeAllContext same as SingularizeAll but stops early once ctx is done,
+// returning the words singularized so far and ctx.Err()
+func (rs *Ruleset) SingularizeAllContext(ctx context.Context, words []string) ([]string, error) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return transformAllContext(ctx, words, rs.singularize)
+}
 
-			result = strings.Replace(result, strings.Join(acronymWords, " "), acronym, 1)
-			acronymWords = []string{}
+func transformAllContext(ctx context.Context, words []string, fn func(string) string) ([]string, error) {
+	if words == nil {
+		return nil, ctx.Err()
+	}
+	out := make([]string, 0, len(words))
+	for i, w := range words {
+		if i%contextCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return out, err
+			}
 		}
+		out = append(out, fn(w))
 	}
+	return out, nil
+}
 
-	return result
+// Pluralize returns the plural form of a singular word
+// An all uppercase word gets an uppercase plural "CATEGORY" -> "CATEGORIES"
+// when a rule matches it, otherwise a lowercase "s", as do acronyms and
+// words without vowels: "CSS" -> "CSSs", "HTTP API" -> "HTTP APIs"
+// Empty and single rune words such as "a" or "é" are returned unchanged
+// A word that is already plural is returned unchanged "categories" -> "categories"
+func (rs *Ruleset) Pluralize(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.pluralize(word)
 }
 
-func (rs *Ruleset) safeCaseAcronyms(word string) string {
-	// convert an acronym like HTML into Html
-	for _, rule := range rs.acronyms {
-		word = strings.Replace(word, rule.suffix, rule.replacement, -1)
+func (rs *Ruleset) pluralize(word string) string {
+	if rs.cache != nil {
+		return rs.cache.lookup(&rs.cache.plurals, word, rs.pluralizeWord)
 	}
-	return word
+	return rs.pluralizeWord(word)
 }
 
-func (rs *Ruleset) separatedWords(word, sep string) string {
-	word = rs.safeCaseAcronyms(word)
-	words := splitAtCaseChange(word)
-	return strings.Join(words, sep)
+func (rs *Ruleset) pluralizeWord(word string) string {
+	plural, _ := rs.pluralizeRule(word)
+	return plural
 }
 
-//Underscore lowercase underscore version "BigBen" -> "big_ben"
-func (rs *Ruleset) Underscore(word string) string {
-	return rs.separatedWords(word, "_")
+// pluralizeRule pluralizes word, reporting whether a rule matched rather
+// than the fallback
+func (rs *Ruleset) pluralizeRule(word string) (string, bool) {
+	if rs.passthroughs[word] {
+		return word, true
+	}
+	if utf8.RuneCountInString(word) <= 1 {
+		return word, false
+	}
+	if isAllUpper(word) && !rs.isAcronym(word) && !rs.endsWithInitialism(word) {
+		// only a real rule is trusted with an uppercase word, anything else
+		// gets the fallback below: "BOX" -> "BOXES" but "UFO" -> "UFOs"
+		if plural, matched := rs.pluralizeRule(strings.ToLower(word)); matched {
+			return strings.ToUpper(plural), true
+		}
+	}
+	lWord := strings.ToLower(word)
+	if rs.isUncountable(lWord) {
+		return word, true
+	}
+	if head, tail, ok := rs.compoundHead(word); ok {
+		plural, matched := rs.pluralizeRule(head)
+		return plural + tail, matched
+	}
+	rule := matchRule(rs.plurals, word, lWord)
+	if rule == nil || !(rule.exact || strings.EqualFold(word, rule.suffix)) {
+		// a word that is already the plural of its singular is left alone,
+		// so Pluralize(Pluralize(word)) == Pluralize(word). Only a real
+		// singular rule counts: the bare "s" -> "" rule would make "thesis"
+		// the plural of "thesi". Irregulars matched as a whole word, like
+		// "cactus", are never taken for plurals.
+		if s := matchRule(rs.singulars, word, lWord); s != nil && !isBareS(s) {
+			singular := rs.applyRule(s, word)
+			lSingular := strings.ToLower(singular)
+			if p := matchRule(rs.plurals, singular, lSingular); p != nil && rs.applyRule(p, singular) == word {
+				return word, true
+			}
+		}
+	}
+	if rule != nil {
+		return rs.applyRule(rule, word), true
+	}
+	if rs.defaultPlural != nil {
+		return rs.defaultPlural(word), false
+	}
+	return word + "s", false
 }
 
-//Humanize First letter of sentence capitalized
-// Uses custom friendly replacements via AddHuman()
-func (rs *Ruleset) Humanize(word string) string {
-	word = replaceLast(word, "_id", "") // strip foreign key kinds
-	// replace and strings in humans list
-	for _, rule := range rs.humans {
-		word = strings.Replace(word, rule.suffix, rule.replacement, -1)
+// endsWithInitialism reports whether the last word of the uppercase word is
+// read letter by letter: a run of registered acronyms, as in "HTTP API" or
+// "JSONAPI", or a word without vowels, as in "CSS" or "X11". Those are
+// pluralized with a lowercase "s" rather than by the rules for English words.
+func (rs *Ruleset) endsWithInitialism(word string) bool {
+	bounds := rs.wordBounds(word)
+	if len(bounds) == 0 {
+		return false
 	}
-	sentence := rs.separatedWords(word, " ")
-
-	r, n := utf8.DecodeRuneInString(sentence)
-	return string(unicode.ToUpper(r)) + sentence[n:]
+	last := word[bounds[len(bounds)-1][0]:bounds[len(bounds)-1][1]]
+	if !strings.ContainsAny(last, "AEIOUY") {
+		return true
+	}
+	for i := 0; i < len(last); {
+		rule, n := rs.acronymAt(last, i, i)
+		if rule == nil {
+			return false
+		}
+		i += n
+	}
+	return true
 }
 
-//ForeignKey an underscored foreign key name "Person" -> "person_id"
-func (rs *Ruleset) ForeignKey(word string) string {
-	return rs.Underscore(rs.Singularize(word)) + "_id"
+// isBareS reports whether rule is the catch-all singular rule "s" -> ""
+func isBareS(rule *Rule) bool {
+	return !rule.exact && rule.suffix == "s" && rule.replacement == ""
 }
 
-//ForeignKeyCondensed a foreign key (with an underscore) "Person" -> "personid"
-func (rs *Ruleset) ForeignKeyCondensed(word string) string {
-	return rs.Underscore(word) + "id"
+// matchRule returns the first of rules matching word, whose lowercase
+// form is lWord, or nil if none does
+func matchRule(rules []*Rule, word, lWord string) *Rule {
+	for _, rule := range rules {
+		if ruleMatches(rule, word, lWord) {
+			return rule
+		}
+	}
+	return nil
 }
 
-//Tableize Rails style pluralized table names: "SuperPerson" -> "super_people"
-func (rs *Ruleset) Tableize(word string) string {
-	return rs.Pluralize(rs.Underscore(rs.Typeify(word)))
+// ruleMatches reports whether rule applies to word, whose lowercase form is lWord
+func ruleMatches(rule *Rule, word, lWord string) bool {
+	if rule.exact {
+		return lWord == rule.suffix
+	}
+	if len(word) == len(rule.suffix) {
+		return strings.EqualFold(word, rule.suffix)
+	}
+	// comparing the last bytes first skips most rules cheaply
+	n := len(rule.suffix)
+	return n < len(word) && (n == 0 || word[len(word)-1] == rule.suffix[n-1]) && word[len(word)-n:] == rule.suffix
 }
 
-var notUrlSafe *regexp.Regexp = regexp.MustCompile(`[^\w\d\-_ ]`)
+// applyRule returns the replacement rule gives for word, which it matches
+func (rs *Ruleset) applyRule(rule *Rule, word string) string {
+	if rule.exact {
+		if isCapitalized(word) {
+			return rs.capitalize(rule.replacement)
+		}
+		return rule.replacement
+	}
+	if strings.EqualFold(word, rule.suffix) {
+		return matchFirstCase(word, rule.replacement)
+	}
+	return replaceLast(word, rule.suffix, rule.replacement)
+}
 
-//Parameterize param safe dasherized names like "my-param"
-func (rs *Ruleset) Parameterize(word string) string {
-	return ParameterizeJoin(word, "-")
+//PluralizeWith same as Pluralize but a word found in overrides, a map of
+// singular to plural matched case insensitively, gets its plural from
+// there instead, without changing the ruleset:
+// ("octopus", {"octopus": "octopuses"}) -> "octopuses"
+// A key matching word exactly wins over keys differing only in case.
+func (rs *Ruleset) PluralizeWith(word string, overrides map[string]string) string {
+	if plural, ok := overrides[word]; ok {
+		return matchFirstCase(word, plural)
+	}
+	// keys differing only in case are tried in a fixed order
+	for _, singular := range sortedKeys(overrides) {
+		if strings.EqualFold(word, singular) {
+			return matchFirstCase(word, overrides[singular])
+		}
+	}
+	return rs.Pluralize(word)
 }
 
-//ParameterizeJoin param safe dasherized names with custom separator
-func (rs *Ruleset) ParameterizeJoin(word, sep string) string {
-	word = strings.ToLower(word)
-	word = rs.Asciify(word)
-	word = notUrlSafe.ReplaceAllString(word, "")
-	word = strings.Replace(word, " ", sep, -1)
-	if len(sep) > 0 {
-		squash, err := regexp.Compile(sep + "+")
-		if err == nil {
-			word = squash.ReplaceAllString(word, sep)
+//SingularizeWith is the inverse of PluralizeWith, taking the same map of
+// singular to plural: ("octopuses", {"octopus": "octopuses"}) -> "octopus"
+func (rs *Ruleset) SingularizeWith(word string, overrides map[string]string) string {
+	keys := sortedKeys(overrides)
+	for _, singular := range keys {
+		if overrides[singular] == word {
+			return matchFirstCase(word, singular)
 		}
 	}
-	word = strings.Trim(word, sep+" ")
-	return word
+	for _, singular := range keys {
+		if strings.EqualFold(word, overrides[singular]) {
+			return matchFirstCase(word, singular)
+		}
+	}
+	return rs.Singularize(word)
 }
 
-var lookalikes = map[string]*regexp.Regexp{
-	"A":  regexp.MustCompile(`À|Á|Â|Ã|Ä|Å`),
-	"AE": regexp.MustCompile(`Æ`),
-	"C":  regexp.MustCompile(`Ç`),
-	"E":  regexp.MustCompile(`È|É|Ê|Ë`),
-	"G":  regexp.MustCompile(`Ğ`),
-	"I":  regexp.MustCompile(`Ì|Í|Î|Ï|İ`),
-	"N":  regexp.MustCompile(`Ñ`),
-	"O":  regexp.MustCompile(`Ò|Ó|Ô|Õ|Ö|Ø`),
-	"S":  regexp.MustCompile(`Ş`),
-	"U":  regexp.MustCompile(`Ù|Ú|Û|Ü`),
-	"Y":  regexp.MustCompile(`Ý`),
-	"ss": regexp.MustCompile(`ß`),
-	"a":  regexp.MustCompile(`à|á|â|ã|ä|å`),
-	"ae": regexp.MustCompile(`æ`),
-	"c":  regexp.MustCompile(`ç`),
-	"e":  regexp.MustCompile(`è|é|ê|ë`),
-	"g":  regexp.MustCompile(`ğ`),
-	"i":  regexp.MustCompile(`ì|í|î|ï|ı`),
-	"n":  regexp.MustCompile(`ñ`),
-	"o":  regexp.MustCompile(`ò|ó|ô|õ|ö|ø`),
-	"s":  regexp.MustCompile(`ş`),
-	"u":  regexp.MustCompile(`ù|ú|û|ü|ũ|ū|ŭ|ů|ű|ų`),
-	"y":  regexp.MustCompile(`ý|ÿ`),
+//PluralizeRule same as Pluralize but also reports whether a rule matched,
+// false when the word only got the fallback plural: "index" -> "indices", true
+// Uncountable words count as matched.
+func (rs *Ruleset) PluralizeRule(word string) (result string, matched bool) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.pluralizeRule(word)
 }
 
-//Asciify transforms Latin characters like é -> e
-func (rs *Ruleset) Asciify(word string) string {
-	for repl, regex := range lookalikes {
-		word = regex.ReplaceAllString(word, repl)
-	}
+//Singularize returns the singular form of a plural word
+// An all uppercase word gets an uppercase singular "CATEGORIES" -> "CATEGORY"
+// when a rule matches it; acronyms and words without vowels are left alone
+// Empty and single rune words such as "s" or "é" are returned unchanged
+func (rs *Ruleset) Singularize(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.singularize(word)
+}
+
+func (rs *Ruleset) singularize(word string) string {
+	if rs.cache != nil {
+		return rs.cache.lookup(&rs.cache.singulars, word, rs.singularizeWord)
+	}
+	return rs.singularizeWord(word)
+}
+
+func (rs *Ruleset) singularizeWord(word string) string {
+	singular, _ := rs.singularizeRule(word)
+	return singular
+}
+
+// singularizeRule singularizes word, reporting whether a rule matched
+// rather than the fallback
+func (rs *Ruleset) singularizeRule(word string) (string, bool) {
+	if rs.passthroughs[word] {
+		return word, true
+	}
+	if utf8.RuneCountInString(word) <= 1 {
+		return word, false
+	}
+	if isAllUpper(word) && !rs.isAcronym(word) && !rs.endsWithInitialism(word) {
+		if singular, matched := rs.singularizeRule(strings.ToLower(word)); matched {
+			return strings.ToUpper(singular), true
+		}
+	}
+	lWord := strings.ToLower(word)
+	if rs.isUncountable(lWord) {
+		return word, true
+	}
+	if head, tail, ok := rs.compoundHead(word); ok {
+		singular, matched := rs.singularizeRule(head)
+		return singular + tail, matched
+	}
+	if rule := matchRule(rs.singulars, word, lWord); rule != nil {
+		return rs.applyRule(rule, word), true
+	}
+	if rs.defaultSingular != nil {
+		return rs.defaultSingular(word), false
+	}
+	return word, false
+}
+
+//SingularizeRule same as Singularize but also reports whether a rule
+// matched, false when the word was left to the fallback
+// Uncountable words count as matched.
+func (rs *Ruleset) SingularizeRule(word string) (result string, matched bool) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.singularizeRule(word)
+}
+
+//Capitalize uppercase first character
+// Registered acronyms are returned in their canonical casing "api" -> "API"
+func (rs *Ruleset) Capitalize(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	return rs.capitalize(word)
+}
+
+func (rs *Ruleset) capitalize(word string) string {
+	if acronym, ok := rs.acronym(word); ok {
+		return acronym
+	}
+	return upperFirst(word)
+}
+
+//Camelize "dino_party" -> "DinoParty"
+// Digits never start a new word, so letters following a digit stay
+// lowercase unless they were already capitalized: "user_2fa_token" -> "User2faToken"
+// Acronyms are not restored, so Camelize(Underscore("IOError")) is "IoError";
+// wrap it in ApplyAcronyms to get "IOError" back once "IO" is registered
+func (rs *Ruleset) Camelize(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.camelize(word)
+}
+
+func (rs *Ruleset) camelize(word string) string {
+	if rs.passthroughs[word] {
+		return word
+	}
+	if rs.isAcronym(word) {
+		return strings.ToUpper(word)
+	}
+	words := rs.splitAtCaseChangeWithTitlecase(word)
+	return strings.Join(words, "")
+}
+
+//CamelizeAcronyms same as Camelize but registered acronyms keep their
+// casing: "api_response" -> "APIResponse", "user_id" -> "UserID"
+func (rs *Ruleset) CamelizeAcronyms(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.camelizeAcronyms(word)
+}
+
+func (rs *Ruleset) camelizeAcronyms(word string) string {
+	word = rs.camelize(word)
+	if rs.passthroughs[word] {
+		return word
+	}
+	return rs.applyAcronyms(word)
+}
+
+//GoName turns word into an exported Go identifier: "user-id" -> "UserID"
+// Characters that are not letters or digits separate words, and a name that
+// would not start with an uppercase letter is prefixed with "X": "2fa_token" -> "X2faToken"
+func (rs *Ruleset) GoName(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	word = strings.Map(func(c rune) rune {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			return c
+		}
+		return '_'
+	}, word)
+	name := rs.camelizeAcronyms(word)
+	if name == "" {
+		return name
+	}
+	if r, _ := utf8.DecodeRuneInString(name); !unicode.IsUpper(r) {
+		name = "X" + name
+	}
+	return name
+}
+
+// JSONStyle selects the naming convention used by JSONNameWithStyle
+type JSONStyle int
+
+const (
+	// JSONCamel is lowerCamel case: "UserID" -> "userId"
+	JSONCamel JSONStyle = iota
+	// JSONSnake is snake case: "UserID" -> "user_id"
+	JSONSnake
+)
+
+//JSONName lowerCamel JSON field name for a Go field name "UserID" -> "userId"
+func (rs *Ruleset) JSONName(word string) string {
+	return rs.JSONNameWithStyle(word, JSONCamel)
+}
+
+//JSONNameWithStyle same as JSONName in the given style
+func (rs *Ruleset) JSONNameWithStyle(word string, style JSONStyle) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	words := rs.splitWords(rs.safeCaseAcronyms(word))
+	if style == JSONSnake {
+		return strings.Join(words, "_")
+	}
+	for i := 1; i < len(words); i++ {
+		words[i] = upperFirst(words[i])
+	}
+	return strings.Join(words, "")
+}
+
+//CamelizeDownFirst same as Camelcase but with the first word downcased
+// A leading acronym is downcased as a whole, so "ID" -> "id",
+// "API" -> "api" and "HTTPServer" -> "httpServer"
+func (rs *Ruleset) CamelizeDownFirst(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	word = rs.camelize(word)
+	bounds := rs.wordBounds(word)
+	if len(bounds) == 0 {
+		return word
+	}
+	end := bounds[0][1]
+	if isAllUpper(word[:end]) {
+		// a plural acronym like "APIs" is one word, not "AP" and "Is"
+		c, n := utf8.DecodeRuneInString(word[end:])
+		if rest := word[end+n:]; unicode.IsUpper(c) && strings.HasPrefix(rest, "s") {
+			if next, _ := utf8.DecodeRuneInString(rest[1:]); len(rest) == 1 || !unicode.IsLower(next) {
+				end += n + 1
+			}
+		}
+	}
+	return strings.ToLower(word[:end]) + word[end:]
+}
+
+//PascalCase "dino_party" -> "DinoParty", an alias for Camelize
+func (rs *Ruleset) PascalCase(word string) string {
+	return rs.Camelize(word)
+}
+
+//CamelCase "dino_party" -> "dinoParty", an alias for CamelizeDownFirst
+func (rs *Ruleset) CamelCase(word string) string {
+	return rs.CamelizeDownFirst(word)
+}
+
+//Titleize Capitalize every word in sentence "hello there" -> "Hello There"
+// Any whole word matching a registered acronym, in any casing, is shown in
+// the acronym's casing: "the html guide" -> "The HTML Guide"
+// including the last word and words next to punctuation: "the api." -> "The API."
+func (rs *Ruleset) Titleize(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.titleize(word)
+}
+
+func (rs *Ruleset) titleize(word string) string {
+	if rs.passthroughs[word] {
+		return word
+	}
+	words := make([]string, 0)
+	last, matchEnd := 0, -1
+	for i := 0; i < len(word); {
+		rule, n := rs.acronymAt(word, i, matchEnd)
+		if rule == nil {
+			_, n = utf8.DecodeRuneInString(word[i:])
+			i += n
+			continue
+		}
+		// punctuation around the acronym, as in "(API)" or "JSON's", stays
+		// attached to it rather than becoming a word of its own
+		start := i
+		for start > last {
+			r, size := utf8.DecodeLastRuneInString(word[last:start])
+			if !unicode.IsPunct(r) || rs.isSpacer(r) {
+				break
+			}
+			start -= size
+		}
+		words = rs.appendTitleWords(words, word[last:start])
+		acronym, _ := rs.acronym(word[i : i+n])
+		acronym = word[start:i] + acronym
+		i += n
+		if strings.HasPrefix(word[i:], "s") {
+			// plural acronym like "APIs"
+			acronym += "s"
+			i++
+		}
+		end := rs.titlePunctEnd(word, i)
+		acronym += word[i:end]
+		words = append(words, acronym)
+		i = end
+		last, matchEnd = i, i
+	}
+	words = rs.appendTitleWords(words, word[last:])
+	words = rs.joinAcronyms(words)
+	for i, w := range words {
+		// punctuation around a word, as in "the api." or "(api)", is kept
+		core := strings.TrimFunc(w, unicode.IsPunct)
+		if core == "" {
+			continue
+		}
+		// "the cat" is a pet, "the CAT" is still the acronym
+		acronym, ok := rs.acronymWord(core)
+		if !ok {
+			continue
+		}
+		start := strings.Index(w, core)
+		words[i] = w[:start] + acronym + w[start+len(core):]
+	}
+	return strings.Join(words, " ")
+}
+
+// titlePunctEnd returns where the punctuation following an acronym at
+// offset i of word ends. Digits after the punctuation, as in "HTTP/2",
+// and a possessive "'s" are included.
+func (rs *Ruleset) titlePunctEnd(word string, i int) int {
+	if r, _ := utf8.DecodeRuneInString(word[i:]); !unicode.IsPunct(r) || rs.isSpacer(r) {
+		return i
+	}
+	for i < len(word) {
+		r, size := utf8.DecodeRuneInString(word[i:])
+		if rs.isSpacer(r) || !(unicode.IsPunct(r) || unicode.IsDigit(r)) {
+			break
+		}
+		i += size
+	}
+	if prev, _ := utf8.DecodeLastRuneInString(word[:i]); prev == '\'' || prev == '’' {
+		if next, _ := utf8.DecodeRuneInString(word[i:]); next == 's' && rs.isWordEnd(word, i+1) {
+			i++
+		}
+	}
+	return i
+}
+
+// SmallWords are the AP style articles, conjunctions and short prepositions
+// that TitleizeWithStyle can keep lowercase
+var SmallWords = []string{
+	"a", "an", "and", "as", "at", "but", "by", "for", "in", "nor",
+	"of", "off", "on", "or", "per", "so", "the", "to", "up", "via", "yet",
+}
+
+//TitleizeWithStyle same as Titleize but keeps smallWords lowercase unless
+// they are the first or last word: "a tale of two cities" -> "A Tale of Two Cities"
+func (rs *Ruleset) TitleizeWithStyle(word string, smallWords []string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	small := make(map[string]bool, len(smallWords))
+	for _, w := range smallWords {
+		small[strings.ToLower(w)] = true
+	}
+	words := strings.Split(rs.titleize(word), " ")
+	for i := 1; i < len(words)-1; i++ {
+		lower := strings.ToLower(words[i])
+		// words[i] is left alone if Titleize kept it as an acronym
+		if small[lower] && words[i] == upperFirst(lower) {
+			words[i] = lower
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+//SplitWords splits an identifier or phrase into its lowercased words, the
+// same way Underscore, Dasherize and Camelize do:
+// "HTTPServer2Go" -> ["http", "server2", "go"]
+// Spaces, underscores, dashes and colons, or the runes set with SetSpacers,
+// separate words and are dropped. A capital starts a new word, but a run of
+// capitals stays together until the last capital before a lowercase letter:
+// "XMLParser" -> ["xml", "parser"]. Digits never start or end a word and
+// stay attached to the letters before them. Empty words are never returned.
+func (rs *Ruleset) SplitWords(word string) []string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.splitWords(word)
+}
+
+//TitleizePreserving uppercases the first letter of every space
+// separated word and leaves the rest of it untouched, so brand names keep
+// their casing: "iPhone and MacBook" -> "iPhone And MacBook"
+// A word with a capital after its first letter is left as it is.
+func (rs *Ruleset) TitleizePreserving(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	words := strings.Split(word, " ")
+	for i, w := range words {
+		if !hasInteriorUpper(w) {
+			words[i] = upperFirst(w)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// hasInteriorUpper reports whether any rune after the first is uppercase
+func hasInteriorUpper(word string) bool {
+	_, n := utf8.DecodeRuneInString(word)
+	for _, r := range word[n:] {
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// appendTitleWords appends the non empty titlecased words of s to words
+func (rs *Ruleset) appendTitleWords(words []string, s string) []string {
+	for _, w := range rs.splitAtCaseChangeWithTitlecase(s) {
+		if w != "" {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// joinAcronyms merges a run of single letter words that spells a registered
+// acronym as a whole: ["My", "U", "R", "L"] -> ["My", "URL"]. Runs are never
+// joined in part, so the letters of "LOCATION" stay apart.
+func (rs *Ruleset) joinAcronyms(words []string) []string {
+	result := make([]string, 0, len(words))
+	for i := 0; i < len(words); {
+		end := i
+		for end < len(words) && utf8.RuneCountInString(words[end]) == 1 {
+			end++
+		}
+		if end == i {
+			result = append(result, words[i])
+			i++
+			continue
+		}
+		if acronym := strings.Join(words[i:end], ""); end > i+1 && rs.isAcronym(acronym) {
+			result = append(result, acronym)
+		} else {
+			result = append(result, words[i:end]...)
+		}
+		i = end
+	}
+	return result
+}
+
+func (rs *Ruleset) safeCaseAcronyms(word string) string {
+	// convert an acronym like HTML into Html, but only where it stands
+	// as a word of its own: "HTMLTidy" -> "HtmlTidy", while "LOCATION" and
+	// "CATEGORY" are untouched
+	var b bytes.Buffer
+	written, matchEnd := 0, -1
+	for i := 0; i < len(word); {
+		if rule, n := rs.acronymAt(word, i, matchEnd); rule != nil {
+			b.WriteString(word[written:i])
+			b.WriteString(rule.replacement)
+			i += n
+			written, matchEnd = i, i
+			continue
+		}
+		_, n := utf8.DecodeRuneInString(word[i:])
+		i += n
+	}
+	if written == 0 {
+		return word
+	}
+	b.WriteString(word[written:])
+	return b.String()
+}
+
+// acronymAt returns the longest acronym, and its length, starting at word
+// boundary i of word and ending at another word boundary. Acronyms match
+// their registered or display casing. A plural "s" may follow the acronym,
+// as in "APIs". matchEnd is where the previous acronym ended.
+func (rs *Ruleset) acronymAt(word string, i, matchEnd int) (*Rule, int) {
+	if i > 0 && i != matchEnd {
+		// only the start of a camel case hump or of a separated word is
+		// a boundary, "Html" in "myHtml" or "html" in "my_html"
+		prev, _ := utf8.DecodeLastRuneInString(word[:i])
+		if unicode.IsUpper(prev) {
+			return nil, 0
+		}
+		if c, _ := utf8.DecodeRuneInString(word[i:]); unicode.IsLetter(prev) && !unicode.IsUpper(c) {
+			return nil, 0
+		}
+	}
+	var match *Rule
+	length := 0
+	for _, rule := range rs.acronymIndex[word[i]] {
+		if rs.isPreservedID(rule) {
+			continue
+		}
+		for _, form := range [2]string{rule.suffix, rule.display} {
+			if len(form) <= length || !strings.HasPrefix(word[i:], form) {
+				continue
+			}
+			if rs.isWordEnd(word, i+len(form)) {
+				match, length = rule, len(form)
+			}
+		}
+	}
+	return match, length
+}
+
+// isWordEnd reports whether a word of word ends at byte offset end.
+// Within a run of capitals the next capital only starts a new word when
+// a lowercase letter follows it, as in "APIKey", or when another acronym
+// starts there, as in "HTMLAPI", so "CATEGORY" is a single word.
+func (rs *Ruleset) isWordEnd(word string, end int) bool {
+	rest := word[end:]
+	next, n := utf8.DecodeRuneInString(rest)
+	switch {
+	case len(rest) == 0:
+		return true
+	case next == 's':
+		after, _ := utf8.DecodeRuneInString(rest[n:])
+		return len(rest) == n || !unicode.IsLower(after)
+	case unicode.IsLower(next):
+		return false
+	case unicode.IsUpper(next):
+		after, _ := utf8.DecodeRuneInString(rest[n:])
+		if unicode.IsLower(after) {
+			return true
+		}
+		rule, _ := rs.acronymAt(word, end, end)
+		return rule != nil
+	}
+	return true
+}
+
+func (rs *Ruleset) separatedWords(word, sep string) string {
+	word = rs.safeCaseAcronyms(word)
+	words := rs.splitWords(word)
+	return strings.Join(words, sep)
+}
+
+//Underscore lowercase underscore version "BigBen" -> "big_ben"
+// Underscore is idempotent: "API_KEY", "APIKey" and "api_key" all give "api_key"
+// Dashes, underscores, spaces and colons are all word boundaries, so mixed
+// or repeated separators give single underscores: "a--b__c" -> "a_b_c"
+func (rs *Ruleset) Underscore(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.underscore(word)
+}
+
+func (rs *Ruleset) underscore(word string) string {
+	if rs.passthroughs[word] {
+		return word
+	}
+	return rs.separatedWords(word, "_")
+}
+
+//SnakeCase lowercase underscore version without acronym handling
+// "parseURLToken" -> "parse_url_token". A run of capitals is kept together
+// as one word, with its last capital starting the next word when followed
+// by a lowercase letter: "HTTPServer" -> "http_server"
+func (rs *Ruleset) SnakeCase(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	return strings.Join(rs.splitWords(word), "_")
+}
+
+//KebabCase lowercase hyphenated version "SomeText" -> "some-text"
+// words are split the same way as SnakeCase
+func (rs *Ruleset) KebabCase(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	return strings.Join(rs.splitWords(word), "-")
+}
+
+//ScreamingSnakeCase uppercase underscore version "SomeConstName" -> "SOME_CONST_NAME"
+// words are split the same way as SnakeCase
+func (rs *Ruleset) ScreamingSnakeCase(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	return strings.ToUpper(strings.Join(rs.splitWords(word), "_"))
+}
+
+//SeparateKeepCase joins the words of word with sep without changing their
+// case: ("SomeHTTPText", "-") -> "Some-HTTP-Text". Words are split the same
+// way as SnakeCase, so registered acronyms get no special treatment.
+func (rs *Ruleset) SeparateKeepCase(word, sep string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	bounds := rs.wordBounds(word)
+	words := make([]string, len(bounds))
+	for i, b := range bounds {
+		words[i] = word[b[0]:b[1]]
+	}
+	return strings.Join(words, sep)
+}
+
+//Humanize First letter of sentence capitalized
+// Uses custom friendly replacements via AddHuman()
+func (rs *Ruleset) Humanize(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	return upperFirst(rs.humanize(word))
+}
+
+//HumanizeLower same as Humanize but without capitalizing the first letter
+// "first_name" -> "first name"
+func (rs *Ruleset) HumanizeLower(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	return rs.humanize(word)
+}
+
+func (rs *Ruleset) humanize(word string) string {
+	word = strings.TrimSuffix(word, "_id") // strip foreign key kinds
+	// replace whole tokens in humans list
+	for _, rule := range rs.humans {
+		word = rs.replaceTokens(word, rule.suffix, rule.replacement)
+	}
+	return rs.separatedWords(word, " ")
+}
+
+// replaceTokens replaces the occurrences of old in s that start and end at
+// spacer characters or the ends of s, so "id" is replaced in "user id" but
+// not in "video"
+func (rs *Ruleset) replaceTokens(s, old, new string) string {
+	if old == "" {
+		return s
+	}
+	var b bytes.Buffer
+	for {
+		i := rs.indexToken(s, old)
+		if i < 0 {
+			break
+		}
+		b.WriteString(s[:i])
+		b.WriteString(new)
+		s = s[i+len(old):]
+	}
+	b.WriteString(s)
+	return b.String()
+}
+
+// indexToken returns the index of the first occurrence of tok in s that is
+// bounded by spacer characters or the ends of s, or -1
+func (rs *Ruleset) indexToken(s, tok string) int {
+	for off := 0; off <= len(s)-len(tok); {
+		i := strings.Index(s[off:], tok)
+		if i < 0 {
+			return -1
+		}
+		i += off
+		before, _ := utf8.DecodeLastRuneInString(s[:i])
+		after, _ := utf8.DecodeRuneInString(s[i+len(tok):])
+		if (i == 0 || rs.isSpacer(before)) && (i+len(tok) == len(s) || rs.isSpacer(after)) {
+			return i
+		}
+		_, n := utf8.DecodeRuneInString(s[i:])
+		off = i + n
+	}
+	return -1
+}
+
+//SentenceCase uppercases the first letter and leaves the rest untouched
+// "an API response" -> "An API response". Leading whitespace is preserved.
+func (rs *Ruleset) SentenceCase(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	i := strings.IndexFunc(word, func(r rune) bool {
+		return !unicode.IsSpace(r)
+	})
+	if i < 0 {
+		return word
+	}
+	r, n := utf8.DecodeRuneInString(word[i:])
+	return word[:i] + string(unicode.ToUpper(r)) + word[i+n:]
+}
+
+//Initials the uppercased first letter of every word
+// "John Quincy Adams" -> "JQA", "big_ben_clock" -> "BBC"
+func (rs *Ruleset) Initials(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	return rs.initials(word, -1)
+}
+
+//InitialsN same as Initials but with at most n letters
+// ("John Quincy Adams", 2) -> "JQ"
+func (rs *Ruleset) InitialsN(word string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	return rs.initials(word, n)
+}
+
+// initials returns the first n initials of word, all of them if n < 0
+func (rs *Ruleset) initials(word string, n int) string {
+	var b bytes.Buffer
+	for i, bound := range rs.wordBounds(word) {
+		if i == n {
+			break
+		}
+		r, _ := utf8.DecodeRuneInString(word[bound[0]:])
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+//SwapCase upcases lowercase letters and downcases uppercase ones
+// "Hello World" -> "hELLO wORLD". Other runes are left as they are.
+func (rs *Ruleset) SwapCase(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsUpper(r):
+			return unicode.ToLower(r)
+		case unicode.IsLower(r):
+			return unicode.ToUpper(r)
+		}
+		return r
+	}, word)
+}
+
+//ForeignKey an underscored foreign key name "Person" -> "person_id"
+func (rs *Ruleset) ForeignKey(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word + "_id"
+	}
+	return rs.underscore(rs.singularize(word)) + "_id"
+}
+
+//ForeignKeyCondensed a foreign key (with an underscore) "Person" -> "personid"
+func (rs *Ruleset) ForeignKeyCondensed(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word + "id"
+	}
+	return rs.underscore(word) + "id"
+}
+
+//Tableize Rails style pluralized table names: "SuperPerson" -> "super_people"
+func (rs *Ruleset) Tableize(word string) string {
+	return rs.Pluralize(rs.Underscore(rs.Typeify(word)))
+}
+
+//TableizeWithSchema same as Tableize but keeps a schema prefix:
+// "public.Account" -> "public.accounts"
+func (rs *Ruleset) TableizeWithSchema(word string) string {
+	return rs.TableizeWithSchemaJoin(word, ".")
+}
+
+//TableizeWithSchemaJoin same as TableizeWithSchema with a custom separator
+// between the schema and the table: ("public.Account", "_") -> "public_accounts"
+func (rs *Ruleset) TableizeWithSchemaJoin(word, sep string) string {
+	i := strings.Index(word, ".")
+	if i < 0 {
+		return rs.Tableize(word)
+	}
+	return rs.Underscore(word[:i]) + sep + rs.Tableize(word[i+1:])
+}
+
+//TableizeType tableizes the name of the type of v: User{} -> "users"
+// Pointers are followed to the type they point to, and values of unnamed
+// types such as anonymous structs, or a nil v, give ""
+func (rs *Ruleset) TableizeType(v interface{}) string {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Name() == "" {
+		return ""
+	}
+	return rs.Tableize(t.Name())
+}
+
+var notUrlSafe *regexp.Regexp = regexp.MustCompile(`[^\w\d\-_ ]`)
+
+//Parameterize param safe dasherized names like "my-param"
+func (rs *Ruleset) Parameterize(word string) string {
+	return rs.ParameterizeJoin(word, "-")
+}
+
+//ParameterizeJoin param safe dasherized names with custom separator
+// Characters are transliterated with Asciify before anything that is
+// not URL safe is dropped
+func (rs *Ruleset) ParameterizeJoin(word, sep string) string {
+	return rs.ParameterizeWithFunc(word, sep, nil)
+}
+
+//ParameterizeMax same as ParameterizeJoin but at most maxLen bytes long,
+// cut at a separator so no partial word is left. A first word longer
+// than maxLen is cut short.
+func (rs *Ruleset) ParameterizeMax(word, sep string, maxLen int) string {
+	slug := rs.ParameterizeJoin(word, sep)
+	if maxLen <= 0 {
+		return ""
+	}
+	if len(slug) <= maxLen {
+		return slug
+	}
+	if sep == "" {
+		return slug[:maxLen]
+	}
+	// the last separator starting at or before maxLen ends the last whole word
+	end := maxLen + len(sep)
+	if end > len(slug) {
+		end = len(slug)
+	}
+	if i := strings.LastIndex(slug[:end], sep); i > 0 && i <= maxLen {
+		return slug[:i]
+	}
+	return slug[:maxLen]
+}
+
+//ParameterizeWithFunc same as ParameterizeJoin but runes Asciify cannot
+// transliterate are passed to fn, so scripts such as CJK can be romanized
+// rather than dropped. A nil fn drops them.
+func (rs *Ruleset) ParameterizeWithFunc(word, sep string, fn func(rune) string) string {
+	return rs.parameterize(word, sep, fn, false)
+}
+
+//Unparameterize a best effort inverse of ParameterizeJoin that turns the
+// separators back into spaces and titleizes: ("hello-world", "-") -> "Hello World"
+// It is lossy, characters and casing dropped by Parameterize are not
+// recovered, so ("cafe-deja-vu", "-") is "Cafe Deja Vu" rather than "Café Déjà Vu!"
+func (rs *Ruleset) Unparameterize(slug, sep string) string {
+	if sep != "" {
+		slug = strings.Replace(slug, sep, " ", -1)
+	}
+	return rs.Titleize(slug)
+}
+
+//ParameterizeKeepCase same as ParameterizeJoin but without lowercasing,
+// for case preserving slugs: ("My Page Title", "-") -> "My-Page-Title"
+func (rs *Ruleset) ParameterizeKeepCase(word, sep string) string {
+	return rs.parameterize(word, sep, nil, true)
+}
+
+func (rs *Ruleset) parameterize(word, sep string, fn func(rune) string, keepCase bool) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	word = normalizeSpaces(word)
+	word = rs.asciify(word)
+	if fn != nil {
+		word = transliterate(word, fn)
+	}
+	if !keepCase {
+		word = strings.ToLower(word)
+	}
+	word = notUrlSafe.ReplaceAllString(word, "")
+	word = strings.Replace(word, " ", sep, -1)
+	if squash := squashRegexp(sep); squash != nil {
+		word = squash.ReplaceAllString(word, sep)
+	}
+	word = strings.Trim(word, sep+" ")
 	return word
 }
 
+// spaceLookalikes are dashes and invisible spaces that separate words in
+// pasted text, so Parameterize treats them as spaces rather than dropping them
+var spaceLookalikes = map[rune]bool{
+	'\u2010': true, // hyphen
+	'\u2011': true, // non-breaking hyphen
+	'\u2012': true, // figure dash
+	'\u2013': true, // en dash
+	'\u2014': true, // em dash
+	'\u2015': true, // horizontal bar
+	'\u2212': true, // minus sign
+	'\u200B': true, // zero width space
+	'\u2060': true, // word joiner
+	'\uFEFF': true, // zero width no-break space
+}
+
+// normalizeSpaces replaces every kind of whitespace and the spaceLookalikes
+// with a plain space
+func normalizeSpaces(word string) string {
+	return strings.Map(func(c rune) rune {
+		if spaceLookalikes[c] || unicode.IsSpace(c) {
+			return ' '
+		}
+		return c
+	}, word)
+}
+
+func transliterate(word string, fn func(rune) string) string {
+	var b bytes.Buffer
+	for _, r := range word {
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+			continue
+		}
+		b.WriteString(fn(r))
+	}
+	return b.String()
+}
+
+// maxSquashRegexps caps how many separators squashRegexp caches, so
+// separators coming from user input cannot grow the cache without bound
+const maxSquashRegexps = 16
+
+var squashRegexps = struct {
+	sync.RWMutex
+	m map[string]*regexp.Regexp
+}{m: map[string]*regexp.Regexp{}}
+
+// squashRegexp returns the regexp matching repeated separators, or nil if
+// sep is empty. The separator is always matched literally. Regexps for the
+// first maxSquashRegexps separators seen are cached.
+func squashRegexp(sep string) *regexp.Regexp {
+	if len(sep) == 0 {
+		return nil
+	}
+	squashRegexps.RLock()
+	re, ok := squashRegexps.m[sep]
+	squashRegexps.RUnlock()
+	if ok {
+		return re
+	}
+	re = regexp.MustCompile("(?:" + regexp.QuoteMeta(sep) + ")+")
+	squashRegexps.Lock()
+	if len(squashRegexps.m) < maxSquashRegexps {
+		squashRegexps.m[sep] = re
+	}
+	squashRegexps.Unlock()
+	return re
+}
+
+var lookalikes = []struct {
+	replacement string
+	re          *regexp.Regexp
+}{
+	{"A", regexp.MustCompile(`À|Á|Â|Ã|Ä|Å`)},
+	{"AE", regexp.MustCompile(`Æ`)},
+	{"C", regexp.MustCompile(`Ç`)},
+	{"D", regexp.MustCompile(`Đ|Ð`)},
+	{"E", regexp.MustCompile(`È|É|Ê|Ë`)},
+	{"G", regexp.MustCompile(`Ğ`)},
+	{"I", regexp.MustCompile(`Ì|Í|Î|Ï|İ`)},
+	{"L", regexp.MustCompile(`Ł`)},
+	{"N", regexp.MustCompile(`Ñ`)},
+	{"O", regexp.MustCompile(`Ò|Ó|Ô|Õ|Ö|Ø`)},
+	{"OE", regexp.MustCompile(`Œ`)},
+	{"S", regexp.MustCompile(`Ş`)},
+	{"Th", regexp.MustCompile(`Þ`)},
+	{"U", regexp.MustCompile(`Ù|Ú|Û|Ü`)},
+	{"Y", regexp.MustCompile(`Ý`)},
+	{"ss", regexp.MustCompile(`ß`)},
+	{"a", regexp.MustCompile(`à|á|â|ã|ä|å`)},
+	{"ae", regexp.MustCompile(`æ`)},
+	{"c", regexp.MustCompile(`ç`)},
+	{"d", regexp.MustCompile(`đ|ð`)},
+	{"e", regexp.MustCompile(`è|é|ê|ë`)},
+	{"g", regexp.MustCompile(`ğ`)},
+	{"i", regexp.MustCompile(`ì|í|î|ï|ı`)},
+	{"l", regexp.MustCompile(`ł`)},
+	{"n", regexp.MustCompile(`ñ`)},
+	{"o", regexp.MustCompile(`ò|ó|ô|õ|ö|ø`)},
+	{"oe", regexp.MustCompile(`œ`)},
+	{"s", regexp.MustCompile(`ş`)},
+	{"th", regexp.MustCompile(`þ`)},
+	{"u", regexp.MustCompile(`ù|ú|û|ü|ũ|ū|ŭ|ů|ű|ų`)},
+	{"y", regexp.MustCompile(`ý|ÿ`)},
+}
+
+//Asciify transforms Latin characters like é -> e
+// Letters missing from the lookalikes table are decomposed and stripped of
+// their combining marks, so "ř" -> "r" and "ệ" -> "e". Other scripts are
+// left untouched.
+func (rs *Ruleset) Asciify(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	return rs.asciify(word)
+}
+
+func (rs *Ruleset) asciify(word string) string {
+	if rs.passthroughs[word] {
+		return word
+	}
+	for _, l := range lookalikes {
+		word = l.re.ReplaceAllString(word, l.replacement)
+	}
+	return stripLatinMarks(word)
+}
+
+func stripLatinMarks(word string) string {
+	var b bytes.Buffer
+	latin := false
+	for _, r := range norm.NFD.String(word) {
+		if unicode.Is(unicode.Mn, r) {
+			if latin {
+				continue
+			}
+		} else {
+			latin = unicode.Is(unicode.Latin, r)
+		}
+		b.WriteRune(r)
+	}
+	return norm.NFC.String(b.String())
+}
+
 var tablePrefix = regexp.MustCompile(`^[^.]*\.`)
 
 //Typeify "something_like_this" -> "SomethingLikeThis"
@@ -615,55 +2300,232 @@ func (rs *Ruleset) Typeify(word string) string {
 	return rs.Camelize(rs.Singularize(word))
 }
 
+//Classify the inverse of Tableize: "admin.super_people" -> "SuperPerson"
+// Any schema prefix is dropped and only the last word is singularized
+func (rs *Ruleset) Classify(tableName string) string {
+	if i := strings.LastIndex(tableName, "."); i >= 0 {
+		tableName = tableName[i+1:]
+	}
+	i := strings.LastIndex(tableName, "_") + 1
+	return rs.Camelize(tableName[:i] + rs.Singularize(tableName[i:]))
+}
+
 //Dasherize "SomeText" -> "some-text"
+// The result is always lowercase, acronym words included: "parseJSONData"
+// -> "parse-json-data" whether or not JSON is a registered acronym.
+// Registered acronyms only decide where words split, so "WiFiRouter" gives
+// "wifi-router" and "getAPIs" gives "get-apis" rather than "get-ap-is".
 func (rs *Ruleset) Dasherize(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
 	return rs.separatedWords(word, "-")
 }
 
 //Ordinalize "1031" -> "1031st"
+// Surrounding whitespace and a leading "+" are ignored: " +21 " -> "21st"
 func (rs *Ruleset) Ordinalize(str string) string {
-	number, err := strconv.Atoi(str)
+	number, err := strconv.Atoi(strings.TrimSpace(str))
 	if err != nil {
 		return str
 	}
+	return rs.OrdinalizeInt(number)
+}
+
+//OrdinalizeInt 1031 -> "1031st"
+func (rs *Ruleset) OrdinalizeInt(number int) string {
+	return strconv.Itoa(number) + ordinalSuffix(number)
+}
+
+//OrdinalizeHTML same as OrdinalizeInt with the suffix superscripted for
+// HTML: 1 -> "1<sup>st</sup>". The output is only digits, a minus sign and
+// the suffix, so it needs no escaping.
+func (rs *Ruleset) OrdinalizeHTML(number int) string {
+	return strconv.Itoa(number) + "<sup>" + ordinalSuffix(number) + "</sup>"
+}
+
+//OrdinalizeBig same as OrdinalizeInt for numbers of arbitrary size
+func (rs *Ruleset) OrdinalizeBig(number *big.Int) string {
+	if number == nil {
+		return ""
+	}
+	mod := new(big.Int).Rem(new(big.Int).Abs(number), big.NewInt(100))
+	return number.String() + ordinalSuffix(int(mod.Int64()))
+}
+
+func ordinalSuffix(number int) string {
 	switch abs(number) % 100 {
 	case 11, 12, 13:
-		return fmt.Sprintf("%dth", number)
+		return "th"
 	default:
 		switch abs(number) % 10 {
 		case 1:
-			return fmt.Sprintf("%dst", number)
+			return "st"
 		case 2:
-			return fmt.Sprintf("%dnd", number)
+			return "nd"
 		case 3:
-			return fmt.Sprintf("%drd", number)
+			return "rd"
 		}
 	}
-	return fmt.Sprintf("%dth", number)
+	return "th"
 }
 
 //ForeignKeyToAttribute returns the attribute name from the foreign key
+// with registered acronyms in their canonical casing "api_key_id" -> "APIKeyID"
 func (rs *Ruleset) ForeignKeyToAttribute(str string) string {
-	w := rs.Camelize(str)
-	if strings.HasSuffix(w, "Id") {
-		return strings.TrimSuffix(w, "Id") + "ID"
+	return rs.ApplyAcronyms(rs.Camelize(str))
+}
+
+//ApplyAcronyms recases every word that is a registered acronym,
+// the inverse of safe casing acronyms: "httpServerUrl" -> "HTTPServerURL"
+func (rs *Ruleset) ApplyAcronyms(word string) string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		return word
+	}
+	return rs.applyAcronyms(word)
+}
+
+// applyAcronyms replaces every word of s that is a registered acronym
+// with its canonical casing, leaving everything else untouched. Acronyms
+// that are also English words, like "post" or "cat", are only recased
+// when already written in capitals.
+func (rs *Ruleset) applyAcronyms(s string) string {
+	var b bytes.Buffer
+	last := 0
+	for _, bound := range rs.wordBounds(s) {
+		acronym, ok := rs.acronymWord(s[bound[0]:bound[1]])
+		if !ok {
+			continue
+		}
+		b.WriteString(s[last:bound[0]])
+		b.WriteString(acronym)
+		last = bound[1]
+	}
+	if last == 0 {
+		return s
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// acronymWord returns the canonical casing of word if it is a registered
+// acronym, or one followed by a plural "s": "ids" -> "IDs", "Apis" -> "APIs".
+// Acronyms that are also English words, like "post", only match in capitals.
+func (rs *Ruleset) acronymWord(word string) (string, bool) {
+	caps := word == strings.ToUpper(word)
+	rule := rs.acronymRule(word)
+	if rule != nil && rule.plural && !caps {
+		// "ids" is more likely the plural of "id" than the acronym "IDS"
+		rule = nil
 	}
-	return w
+	if rule == nil && len(word) > 1 && strings.HasSuffix(word, "s") {
+		stem := word[:len(word)-1]
+		if r := rs.acronymRule(stem); r != nil && !(r.word && stem != strings.ToUpper(stem)) {
+			return acronymDisplay(r) + "s", true
+		}
+	}
+	if rule == nil || (rule.word && !caps) {
+		return "", false
+	}
+	return acronymDisplay(rule), true
 }
 
-//LoadReader loads rules from io.Reader param
+// Inflections describes every kind of rule the Add* methods can add to
+// a ruleset. Irregulars map singular to plural forms, Plurals and
+// Singulars map suffixes to their replacements.
+type Inflections struct {
+	Plurals      map[string]string `json:"plurals,omitempty" yaml:"plurals"`
+	Singulars    map[string]string `json:"singulars,omitempty" yaml:"singulars"`
+	Irregulars   map[string]string `json:"irregulars,omitempty" yaml:"irregulars"`
+	Uncountables []string          `json:"uncountables,omitempty" yaml:"uncountables"`
+	Acronyms     []string          `json:"acronyms,omitempty" yaml:"acronyms"`
+}
+
+//Load applies every section of cfg to the ruleset
+func (rs *Ruleset) Load(cfg Inflections) {
+	for _, k := range sortedKeys(cfg.Plurals) {
+		rs.AddPlural(k, cfg.Plurals[k])
+	}
+	for _, k := range sortedKeys(cfg.Singulars) {
+		rs.AddSingular(k, cfg.Singulars[k])
+	}
+	for _, k := range sortedKeys(cfg.Irregulars) {
+		rs.AddIrregular(k, cfg.Irregulars[k])
+	}
+	for _, w := range cfg.Uncountables {
+		rs.AddUncountable(w)
+	}
+	for _, w := range cfg.Acronyms {
+		rs.AddAcronym(w)
+	}
+}
+
+//LoadReader loads rules from io.Reader param. The JSON can either be an
+// Inflections document or a flat map of singular to plural irregulars
 func (rs *Ruleset) LoadReader(r io.Reader) error {
-	m := map[string]string{}
+	m := map[string]json.RawMessage{}
 	err := json.NewDecoder(r).Decode(&m)
 	if err != nil {
 		return fmt.Errorf("could not decode inflection JSON from reader: %s", err)
 	}
-	for s, p := range m {
-		defaultRuleset.AddIrregular(s, p)
+
+	var cfg Inflections
+	if isIrregularsMap(m) {
+		cfg.Irregulars = make(map[string]string, len(m))
+		for s, p := range m {
+			var plural string
+			if err = json.Unmarshal(p, &plural); err != nil {
+				return fmt.Errorf("could not decode inflection JSON from reader: %s", err)
+			}
+			cfg.Irregulars[s] = plural
+		}
+	} else {
+		b, _ := json.Marshal(m)
+		if err = json.Unmarshal(b, &cfg); err != nil {
+			return fmt.Errorf("could not decode inflection JSON from reader: %s", err)
+		}
+	}
+	rs.Load(cfg)
+	return nil
+}
+
+// isIrregularsMap reports whether m is the flat singular to plural format
+func isIrregularsMap(m map[string]json.RawMessage) bool {
+	for _, v := range m {
+		if len(v) == 0 || v[0] != '"' {
+			return false
+		}
+	}
+	return true
+}
+
+//LoadYAML loads rules from a YAML Inflections document
+func (rs *Ruleset) LoadYAML(r io.Reader) error {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("could not read inflection YAML from reader: %s", err)
+	}
+	var cfg Inflections
+	if err = yaml.Unmarshal(b, &cfg); err != nil {
+		return fmt.Errorf("could not decode inflection YAML from reader: %s", err)
 	}
+	rs.Load(cfg)
 	return nil
 }
 
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 /////////////////////////////////////////
 // the default global ruleset
 //////////////////////////////////////////
@@ -675,24 +2537,60 @@ func LoadReader(r io.Reader) error {
 	return defaultRuleset.LoadReader(r)
 }
 
+//LoadYAML loads YAML rules from io.Reader param
+func LoadYAML(r io.Reader) error {
+	return defaultRuleset.LoadYAML(r)
+}
+
+//Load applies the inflections to the default ruleset
+func Load(cfg Inflections) {
+	defaultRuleset.Load(cfg)
+}
+
+var loadErr error
+
+//LoadError returns the error, if any, encountered while loading the
+// INFLECT_PATH file when the package was initialized
+func LoadError() error {
+	return loadErr
+}
+
 func init() {
 	defaultRuleset = NewDefaultRuleset()
+	loadErr = loadEnvFile(defaultRuleset)
+	Register("en", defaultRuleset)
+}
 
-	pwd, _ := os.Getwd()
-	cfg := filepath.Join(pwd, "inflections.json")
+// loadEnvFile loads the file named by INFLECT_PATH, if set, into rs
+func loadEnvFile(rs *Ruleset) error {
 	if p := os.Getenv("INFLECT_PATH"); p != "" {
-		cfg = p
+		return loadFile(rs, p)
 	}
-	if _, err := os.Stat(cfg); err == nil {
-		b, err := ioutil.ReadFile(cfg)
-		if err != nil {
-			fmt.Printf("could not read inflection file %s (%s)\n", cfg, err)
-			return
-		}
-		if err = defaultRuleset.LoadReader(bytes.NewReader(b)); err != nil {
-			fmt.Println(err)
-		}
+	return nil
+}
+
+//LoadDefaultFile loads the file named by INFLECT_PATH, or inflections.json
+// in the current working directory, into the default ruleset. A missing
+// file is not an error
+func LoadDefaultFile() error {
+	cfg := os.Getenv("INFLECT_PATH")
+	if cfg == "" {
+		pwd, _ := os.Getwd()
+		cfg = filepath.Join(pwd, "inflections.json")
 	}
+	return loadFile(defaultRuleset, cfg)
+}
+
+// loadFile loads the rules in path into rs, a missing file is not an error
+func loadFile(rs *Ruleset, path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return nil
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read inflection file %s (%s)", path, err)
+	}
+	return rs.LoadReader(bytes.NewReader(b))
 }
 
 //Uncountables returns a list of uncountables rules
@@ -719,14 +2617,78 @@ func AddIrregular(singular, plural string) {
 	defaultRuleset.AddIrregular(singular, plural)
 }
 
+func AddIrregulars(pairs map[string]string) {
+	defaultRuleset.AddIrregulars(pairs)
+}
+
+func AddPhrase(singular, plural string) {
+	defaultRuleset.AddPhrase(singular, plural)
+}
+
+func AddCompound(tail string) {
+	defaultRuleset.AddCompound(tail)
+}
+
+func AddScientificPlurals() {
+	defaultRuleset.AddScientificPlurals()
+}
+
 func AddAcronym(word string) {
 	defaultRuleset.AddAcronym(word)
 }
 
+func AddAcronyms(words ...string) {
+	defaultRuleset.AddAcronyms(words...)
+}
+
+func AddAcronymsFromReader(r io.Reader) error {
+	return defaultRuleset.AddAcronymsFromReader(r)
+}
+
+func AddAcronymExact(word, display string) {
+	defaultRuleset.AddAcronymExact(word, display)
+}
+
 func AddUncountable(word string) {
 	defaultRuleset.AddUncountable(word)
 }
 
+func AddPassthrough(word string) {
+	defaultRuleset.AddPassthrough(word)
+}
+
+func IsPassthrough(word string) bool {
+	return defaultRuleset.IsPassthrough(word)
+}
+
+func IsUncountable(word string) bool {
+	return defaultRuleset.IsUncountable(word)
+}
+
+func IsPlural(word string) bool {
+	return defaultRuleset.IsPlural(word)
+}
+
+func IsSingular(word string) bool {
+	return defaultRuleset.IsSingular(word)
+}
+
+func RemovePlural(suffix string) bool {
+	return defaultRuleset.RemovePlural(suffix)
+}
+
+func RemoveSingular(suffix string) bool {
+	return defaultRuleset.RemoveSingular(suffix)
+}
+
+func RemoveUncountable(word string) {
+	defaultRuleset.RemoveUncountable(word)
+}
+
+func RemoveAcronym(word string) {
+	defaultRuleset.RemoveAcronym(word)
+}
+
 func Pluralize(word string) string {
 	return defaultRuleset.Pluralize(word)
 }
@@ -735,10 +2697,62 @@ func PluralizeWithSize(word string, size int) string {
 	return defaultRuleset.PluralizeWithSize(word, size)
 }
 
+func PluralizeWithCount(count int, word string) string {
+	return defaultRuleset.PluralizeWithCount(count, word)
+}
+
+func Possessive(word string, plural bool) string {
+	return defaultRuleset.Possessive(word, plural)
+}
+
+func PossessiveWithStyle(word string, plural bool, style PossessiveStyle) string {
+	return defaultRuleset.PossessiveWithStyle(word, plural, style)
+}
+
+func HumanizeCount(count int, word string) string {
+	return defaultRuleset.HumanizeCount(count, word)
+}
+
+func PluralizePhrase(phrase string) string {
+	return defaultRuleset.PluralizePhrase(phrase)
+}
+
+func PluralizeAll(words []string) []string {
+	return defaultRuleset.PluralizeAll(words)
+}
+
+func SingularizeAll(words []string) []string {
+	return defaultRuleset.SingularizeAll(words)
+}
+
+func PluralizeAllContext(ctx context.Context, words []string) ([]string, error) {
+	return defaultRuleset.PluralizeAllContext(ctx, words)
+}
+
+func SingularizeAllContext(ctx context.Context, words []string) ([]string, error) {
+	return defaultRuleset.SingularizeAllContext(ctx, words)
+}
+
 func Singularize(word string) string {
 	return defaultRuleset.Singularize(word)
 }
 
+func PluralizeRule(word string) (string, bool) {
+	return defaultRuleset.PluralizeRule(word)
+}
+
+func SingularizeRule(word string) (string, bool) {
+	return defaultRuleset.SingularizeRule(word)
+}
+
+func PluralizeWith(word string, overrides map[string]string) string {
+	return defaultRuleset.PluralizeWith(word, overrides)
+}
+
+func SingularizeWith(word string, overrides map[string]string) string {
+	return defaultRuleset.SingularizeWith(word, overrides)
+}
+
 func Capitalize(word string) string {
 	return defaultRuleset.Capitalize(word)
 }
@@ -747,22 +2761,94 @@ func Camelize(word string) string {
 	return defaultRuleset.Camelize(word)
 }
 
+func CamelizeAcronyms(word string) string {
+	return defaultRuleset.CamelizeAcronyms(word)
+}
+
+func GoName(word string) string {
+	return defaultRuleset.GoName(word)
+}
+
+func JSONName(word string) string {
+	return defaultRuleset.JSONName(word)
+}
+
+func JSONNameWithStyle(word string, style JSONStyle) string {
+	return defaultRuleset.JSONNameWithStyle(word, style)
+}
+
 func CamelizeDownFirst(word string) string {
 	return defaultRuleset.CamelizeDownFirst(word)
 }
 
+func PascalCase(word string) string {
+	return defaultRuleset.PascalCase(word)
+}
+
+func CamelCase(word string) string {
+	return defaultRuleset.CamelCase(word)
+}
+
 func Titleize(word string) string {
 	return defaultRuleset.Titleize(word)
 }
 
+func TitleizeWithStyle(word string, smallWords []string) string {
+	return defaultRuleset.TitleizeWithStyle(word, smallWords)
+}
+
+func SplitWords(word string) []string {
+	return defaultRuleset.SplitWords(word)
+}
+
+func TitleizePreserving(word string) string {
+	return defaultRuleset.TitleizePreserving(word)
+}
+
 func Underscore(word string) string {
 	return defaultRuleset.Underscore(word)
 }
 
+func SnakeCase(word string) string {
+	return defaultRuleset.SnakeCase(word)
+}
+
+func KebabCase(word string) string {
+	return defaultRuleset.KebabCase(word)
+}
+
+func ScreamingSnakeCase(word string) string {
+	return defaultRuleset.ScreamingSnakeCase(word)
+}
+
+func SeparateKeepCase(word, sep string) string {
+	return defaultRuleset.SeparateKeepCase(word, sep)
+}
+
 func Humanize(word string) string {
 	return defaultRuleset.Humanize(word)
 }
 
+func HumanizeLower(word string) string {
+	return defaultRuleset.HumanizeLower(word)
+}
+
+func SentenceCase(word string) string {
+	return defaultRuleset.SentenceCase(word)
+}
+
+func SwapCase(word string) string {
+	return defaultRuleset.SwapCase(word)
+}
+
+func Initials(word string) string {
+	return defaultRuleset.Initials(word)
+}
+
+func InitialsN(word string, n int) string {
+	return defaultRuleset.InitialsN(word, n)
+}
+
 func ForeignKey(word string) string {
 	return defaultRuleset.ForeignKey(word)
 }
@@ -775,6 +2861,18 @@ func Tableize(word string) string {
 	return defaultRuleset.Tableize(word)
 }
 
+func TableizeType(v interface{}) string {
+	return defaultRuleset.TableizeType(v)
+}
+
+func TableizeWithSchema(word string) string {
+	return defaultRuleset.TableizeWithSchema(word)
+}
+
+func TableizeWithSchemaJoin(word, sep string) string {
+	return defaultRuleset.TableizeWithSchemaJoin(word, sep)
+}
+
 func Parameterize(word string) string {
 	return defaultRuleset.Parameterize(word)
 }
@@ -783,10 +2881,30 @@ func ParameterizeJoin(word, sep string) string {
 	return defaultRuleset.ParameterizeJoin(word, sep)
 }
 
+func ParameterizeMax(word, sep string, maxLen int) string {
+	return defaultRuleset.ParameterizeMax(word, sep, maxLen)
+}
+
+func ParameterizeWithFunc(word, sep string, fn func(rune) string) string {
+	return defaultRuleset.ParameterizeWithFunc(word, sep, fn)
+}
+
+func Unparameterize(slug, sep string) string {
+	return defaultRuleset.Unparameterize(slug, sep)
+}
+
+func ParameterizeKeepCase(word, sep string) string {
+	return defaultRuleset.ParameterizeKeepCase(word, sep)
+}
+
 func Typeify(word string) string {
 	return defaultRuleset.Typeify(word)
 }
 
+func Classify(tableName string) string {
+	return defaultRuleset.Classify(tableName)
+}
+
 func Dasherize(word string) string {
 	return defaultRuleset.Dasherize(word)
 }
@@ -795,24 +2913,82 @@ func Ordinalize(word string) string {
 	return defaultRuleset.Ordinalize(word)
 }
 
+func OrdinalizeInt(number int) string {
+	return defaultRuleset.OrdinalizeInt(number)
+}
+
+func OrdinalizeHTML(number int) string {
+	return defaultRuleset.OrdinalizeHTML(number)
+}
+
+func OrdinalizeBig(number *big.Int) string {
+	return defaultRuleset.OrdinalizeBig(number)
+}
+
 func Asciify(word string) string {
 	return defaultRuleset.Asciify(word)
 }
 
+func ApplyAcronyms(word string) string {
+	return defaultRuleset.ApplyAcronyms(word)
+}
+
 func ForeignKeyToAttribute(word string) string {
 	return defaultRuleset.ForeignKeyToAttribute(word)
 }
 
 // helper funcs
 
-func reverse(s string) string {
-	o := make([]rune, utf8.RuneCountInString(s))
-	i := len(o)
-	for _, c := range s {
-		i--
-		o[i] = c
+// isAllUpper reports whether s has cased letters and all of them are uppercase
+func isAllUpper(s string) bool {
+	return strings.ToUpper(s) == s && strings.ToLower(s) != s
+}
+
+// isCapitalized reports whether word is an uppercase rune followed by
+// lowercase: "Ångström" but not "ångström" or "ÅNGSTRÖM"
+func isCapitalized(word string) bool {
+	r, n := utf8.DecodeRuneInString(word)
+	return unicode.IsUpper(r) && strings.ToLower(word[n:]) == word[n:]
+}
+
+// matchFirstCase uppercases the first rune of replacement if word starts
+// with an uppercase rune, so irregulars keep their capital: "Person" -> "People"
+func matchFirstCase(word, replacement string) string {
+	if r, _ := utf8.DecodeRuneInString(word); unicode.IsUpper(r) {
+		return upperFirst(replacement)
 	}
-	return string(o)
+	return replacement
+}
+
+// upperFirst uppercases the first rune of s
+func upperFirst(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	if n == 0 {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + s[n:]
+}
+
+// lowerFirst lowercases the first rune of s
+func lowerFirst(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	if n == 0 {
+		return s
+	}
+	return string(unicode.ToLower(r)) + s[n:]
+}
+
+// isSpacer reports whether c separates words in this ruleset
+func (rs *Ruleset) isSpacer(c rune) bool {
+	if rs.spacers == nil {
+		return isSpacerChar(c)
+	}
+	for _, r := range rs.spacers {
+		if r == c {
+			return true
+		}
+	}
+	return false
 }
 
 func isSpacerChar(c rune) bool {
@@ -829,31 +3005,61 @@ func isSpacerChar(c rune) bool {
 	return false
 }
 
-func splitAtCaseChange(s string) []string {
-	words := make([]string, 0)
-	word := make([]rune, 0)
-	for _, c := range s {
-		spacer := isSpacerChar(c)
-		if len(word) > 0 {
-			if unicode.IsUpper(c) || spacer {
-				words = append(words, string(word))
-				word = make([]rune, 0)
+// splitWords splits s into lowercased words at spacer characters and case
+// changes, keeping runs of capitals such as "HTTP" together. Empty words are
+// never returned.
+func (rs *Ruleset) splitWords(s string) []string {
+	bounds := rs.wordBounds(s)
+	words := make([]string, len(bounds))
+	for i, b := range bounds {
+		words[i] = strings.ToLower(s[b[0]:b[1]])
+	}
+	return words
+}
+
+// wordBounds returns the byte offsets of the start and end of every word
+// in s, using the same boundaries as splitWords
+func (rs *Ruleset) wordBounds(s string) [][2]int {
+	bounds := make([][2]int, 0)
+	start := -1
+	var prev rune
+	for i, c := range s {
+		if rs.isSpacer(c) {
+			if start >= 0 {
+				bounds = append(bounds, [2]int{start, i})
+				start = -1
 			}
+			continue
 		}
-		if !spacer {
-			word = append(word, unicode.ToLower(c))
+		if start >= 0 && unicode.IsUpper(c) {
+			_, n := utf8.DecodeRuneInString(s[i:])
+			next, _ := utf8.DecodeRuneInString(s[i+n:])
+			if !unicode.IsUpper(prev) || unicode.IsLower(next) {
+				bounds = append(bounds, [2]int{start, i})
+				start = i
+			}
+		}
+		if start < 0 {
+			start = i
 		}
+		prev = c
 	}
-	words = append(words, string(word))
-	return words
+	if start >= 0 {
+		bounds = append(bounds, [2]int{start, len(s)})
+	}
+	return bounds
 }
 
-func splitAtCaseChangeWithTitlecase(s string) []string {
+// splitAtCaseChangeWithTitlecase splits s at spacer characters and capitals,
+// titlecasing each word. Digits are treated like lowercase letters: they
+// neither start nor end a word, so "mp3_player" gives "Mp3" and "Player"
+// while "mp3Player" gives the same words.
+func (rs *Ruleset) splitAtCaseChangeWithTitlecase(s string) []string {
 	words := make([]string, 0)
 	word := make([]rune, 0)
 
 	for _, c := range s {
-		spacer := isSpacerChar(c)
+		spacer := rs.isSpacer(c)
 		if len(word) > 0 {
 			if unicode.IsUpper(c) || spacer {
 				words = append(words, string(word))
@@ -874,12 +3080,11 @@ func splitAtCaseChangeWithTitlecase(s string) []string {
 }
 
 func replaceLast(s, match, repl string) string {
-	// reverse strings
-	srev := reverse(s)
-	mrev := reverse(match)
-	rrev := reverse(repl)
-	// match first and reverse back
-	return reverse(strings.Replace(srev, mrev, rrev, 1))
+	i := strings.LastIndex(s, match)
+	if i < 0 {
+		return s
+	}
+	return s[:i] + repl + s[i+len(match):]
 }
 
 func abs(x int) int {
@@ -1,6 +1,17 @@
 package inflect
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -40,8 +51,6 @@ var SingularToPlural = map[string]string{
 	"basis":       "bases",
 	"diagnosis":   "diagnoses",
 	"diagnosis_a": "diagnosis_as",
-	"datum":       "data",
-	"medium":      "media",
 	"stadium":     "stadia",
 	"analysis":    "analyses",
 	"node_child":  "node_children",
@@ -178,12 +187,18 @@ var StringToParameterizeWithUnderscore = map[string]string{
 }
 
 var StringToParameterizedAndNormalized = map[string]string{
-	"Malmö":         "malmo",
-	"Garçons":       "garcons",
-	"Opsů":          "opsu",
-	"Ærøskøbing":    "aeroskobing",
-	"Aßlar":         "asslar",
-	"Japanese: 日本語": "japanese",
+	"Malmö":              "malmo",
+	"Garçons":            "garcons",
+	"Opsů":               "opsu",
+	"Ærøskøbing":         "aeroskobing",
+	"Aßlar":              "asslar",
+	"Japanese: 日本語":      "japanese",
+	"Crème Brûlée":       "creme-brulee",
+	"Où est l'hôtel":     "ou-est-lhotel",
+	"Über Größe":         "uber-grosse",
+	"Fußgängerübergänge": "fussgangerubergange",
+	"日本語":                "",
+	"Привет мир":         "",
 }
 
 var UnderscoreToHuman = map[string]string{
@@ -191,6 +206,12 @@ var UnderscoreToHuman = map[string]string{
 	"employee_id":     "Employee",
 	"underground":     "Underground",
 	"óbito":           "Óbito",
+	"person_id":       "Person",
+	"valid_id":        "Valid",
+	"void_id":         "Void",
+	"grid_size":       "Grid size",
+	"identity_column": "Identity column",
+	"user_identifier": "User identifier",
 }
 
 var MixtureToTitleCase = map[string]string{
@@ -286,6 +307,94 @@ var Irregularities = map[string]string{
 	"move":   "moves",
 }
 
+// RoundTripNouns are common English (and programming) nouns for which
+// Singularize(Pluralize(noun)) must give back the noun
+var RoundTripNouns = strings.Fields(`
+	account address agency agent airport album alarm alias analysis animal
+	answer apartment apple application approach archive area argument army
+	array article artist aspect asset attempt attribute audience author
+	authority avenue axis baby backup badge bag balance ball band bank bar
+	base basis basket batch battery beach bean bear bed bee beer bell belt
+	bench benefit berry bill bird birthday blade block blog board boat body
+	bone book boot border boss bottle box boy brain branch brand bread bridge
+	browser brush bucket budget buffer bug building bus business button buyer
+	cable cache cafe cake calendar call camera campaign campus candidate
+	candle cap car card career carrier case cash castle cat category cause
+	cell century certificate chain chair challenge champion change channel
+	chapter character charge chart check cheese chef cherry child chip choice
+	church circle city claim class client cloud club coach coat code coin
+	collection college colony color column comment commit community company
+	comparison competition component computer concept condition conference
+	config connection consumer contact container content context contract
+	controller cookie copy corner cost country county couple course court
+	cousin cow credit crisis criterion crowd culture cup currency curve
+	customer cycle dance database date datum day deal debate decade decision
+	degree delay delivery demand department deployment deposit design desk
+	detail device diagnosis diagram dictionary difference dinner direction
+	directory discount discussion disease dish disk display distance district
+	doctor document dog dollar domain door draft drawer dream dress drink
+	driver duty dwarf eagle earthquake economy edge editor effect egg
+	election element elephant email employee energy engine engineer entity
+	entry environment episode error essay estate event evidence example
+	exception exchange exercise expense experience expert export expression
+	extension eye face fact factor factory failure family fan farm fax
+	feature fee feeling field file film filter finding fire firm fish fix
+	flag flight floor flower focus folder food foot force forest fork form
+	format formula fox frame friend fruit function fund game garden gate gene
+	genius ghost gift girl glass goal goat god grade graph grass group growth
+	guard guest guide guitar gun habit hair half hall hand handler hash hat
+	head health heart hero highway hill history hobby hole holiday home hook
+	horse hospital host hotel hour house idea identity image impact import
+	incident income index industry inquiry insect instance institution
+	instrument insurance interest interface internet interview invoice island
+	issue item job joke journal journey judge juice key keyboard kid king
+	kiss kitchen knife knot label lady lake lamp language laptop law layer
+	leader leaf league lesson letter level library license life light limit
+	line link list loan location lock log loss lottery machine magazine
+	manager map market mask match matrix meal medium meeting member memory
+	menu message metal method metric middleware minute mirror mission mistake
+	model module moment money monkey month mood morning mother motor mountain
+	mouse mouth movie museum music name nation network news night node note
+	notice number nurse object office officer opinion option orange order
+	organization output owner package page pair panel paper parameter parent
+	park party passage password patch path patient pattern payment peak pen
+	pencil penny person phase phone photo piano picture piece pig pipeline
+	pizza place plan planet plant plate platform player plugin pocket poem
+	point policy pool population port portfolio position post potato pound
+	power practice prefix presence president price princess principle printer
+	priority prize problem process product profile program project property
+	proxy purpose puzzle quality quantity query queue question quiz radio
+	range rating ratio reaction reader reality reason receipt recipe record
+	reference region release replica report repository request resource
+	response restaurant result review reward ride right risk river road robot
+	role room route router row rule salad sale sample scale scene schedule
+	schema school science score screen script search season seat secret
+	section sector seed selection sender sentence series server service
+	session setting shape share shelf shell shift ship shirt shoe shop show
+	sign signal signature sister site size skill sky slice snapshot society
+	sock software solution song source space speaker species speech spider
+	sport spot spring square staff stage stair standard star state statement
+	station status step stock stone store story strategy stream street string
+	structure student studio study style subject success suffix suggestion
+	summary supply surface survey switch symbol system table tag task tax
+	teacher team technology template tenant term test text theme theory thing
+	thread ticket tiger time title token tomato tool tooth topic tower town
+	toy track trade tradition traffic train transaction tree trend trial trip
+	truck truth type uncle union unit university update upload user vacation
+	valley value variable vendor version video view village virus visit voice
+	volume vote wallet warehouse watch water wave way weapon web website week
+	widget wife window winner wish wolf woman word worker workflow world
+	writer year zone
+`)
+
+// RoundTripExceptions are nouns whose plural is shared with another noun,
+// mapped to what the round trip gives back instead
+var RoundTripExceptions = map[string]string{
+	"base":   "basis", // "bases" is the plural of both
+	"datum":  "data",  // uncountable unless AddScientificPlurals is used
+	"medium": "media", // uncountable unless AddScientificPlurals is used
+}
+
 type AcronymCase struct {
 	camel string
 	under string
@@ -319,13 +428,110 @@ var AcronymCases = []*AcronymCase{
 // tests
 
 func Test_LoadViaFile(t *testing.T) {
+	require.NoError(t, LoadDefaultFile())
 	require.Equal(t, "feedback", Pluralize("feedback"))
 	require.Equal(t, "buffalo!", Singularize("buffalos!"))
 }
 
+func Test_LoadError(t *testing.T) {
+	r := require.New(t)
+	r.NoError(LoadError())
+
+	dir, err := ioutil.TempDir("", "inflect")
+	r.NoError(err)
+	defer os.RemoveAll(dir)
+	cfg := filepath.Join(dir, "inflections.json")
+	r.NoError(ioutil.WriteFile(cfg, []byte(`{"broken":`), 0644))
+
+	stdout := os.Stdout
+	pr, pw, err := os.Pipe()
+	r.NoError(err)
+	os.Stdout = pw
+	err = loadFile(NewRuleset(), cfg)
+	os.Stdout = stdout
+	pw.Close()
+	out, _ := ioutil.ReadAll(pr)
+
+	r.Error(err)
+	r.Empty(out)
+	r.NoError(loadFile(NewRuleset(), filepath.Join(dir, "missing.json")))
+}
+
+func Test_LoadIgnoresWorkingDirectory(t *testing.T) {
+	r := require.New(t)
+
+	dir, err := ioutil.TempDir("", "inflect")
+	r.NoError(err)
+	defer os.RemoveAll(dir)
+	r.NoError(ioutil.WriteFile(filepath.Join(dir, "inflections.json"), []byte(`{"stray": "strayz"}`), 0644))
+
+	pwd, err := os.Getwd()
+	r.NoError(err)
+	r.NoError(os.Chdir(dir))
+	defer os.Chdir(pwd)
+	env, ok := os.LookupEnv("INFLECT_PATH")
+	os.Unsetenv("INFLECT_PATH")
+	if ok {
+		defer os.Setenv("INFLECT_PATH", env)
+	}
+
+	rs := NewDefaultRuleset()
+	r.NoError(loadEnvFile(rs))
+	r.Equal("strays", rs.Pluralize("stray"))
+
+	os.Setenv("INFLECT_PATH", filepath.Join(dir, "inflections.json"))
+	defer os.Unsetenv("INFLECT_PATH")
+	r.NoError(loadEnvFile(rs))
+	r.Equal("strayz", rs.Pluralize("stray"))
+}
+
 func TestForeignKeyToAttribute(t *testing.T) {
 	require.Equal(t, "PersonID", ForeignKeyToAttribute("person_id"))
 	require.Equal(t, "ID", ForeignKeyToAttribute("id"))
+	require.Equal(t, "APIKeyID", ForeignKeyToAttribute("api_key_id"))
+	require.Equal(t, "UserURLID", ForeignKeyToAttribute("user_url_id"))
+	require.Equal(t, "HTTPSProxyUUID", ForeignKeyToAttribute("https_proxy_uuid"))
+	require.Equal(t, "Identity", ForeignKeyToAttribute("identity"))
+	require.Equal(t, "PostID", ForeignKeyToAttribute("post_id"))
+	require.Equal(t, "UserIDs", ForeignKeyToAttribute("user_ids"))
+	require.Equal(t, "OrderIDs", ForeignKeyToAttribute("order_ids"))
+	require.Equal(t, "CatID", ForeignKeyToAttribute("cat_id"))
+	require.Equal(t, "MacAddress", ForeignKeyToAttribute("mac_address"))
+	require.Equal(t, "PostTitle", ForeignKeyToAttribute("post_title"))
+}
+
+func TestApplyAcronyms(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "httpServerUrl", E: "HTTPServerURL"},
+		{V: "xmlHttpRequest", E: "xmlHTTPRequest"},
+		{V: "userId", E: "userID"},
+		{V: "api_key", E: "API_key"},
+		{V: "getIpad", E: "getIpad"},
+		{V: "zipCode", E: "zipCode"},
+		{V: "ipv4Address", E: "ipv4Address"},
+		{V: "getIpAddress", E: "getIPAddress"},
+		{V: "HTTPServer", E: "HTTPServer"},
+		{V: "postTitle", E: "postTitle"},
+		{V: "catId", E: "catID"},
+		{V: "ramSize", E: "ramSize"},
+		{V: "RAMSize", E: "RAMSize"},
+		{V: "ips", E: "IPs"},
+		{V: "userIds", E: "userIDs"},
+		{V: "httpsApis", E: "HTTPSAPIs"},
+		{V: "userIPS", E: "userIPS"},
+		{V: "", E: ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, rs.ApplyAcronyms(tt.V))
+	}
+
+	rs.AddAcronym("XML")
+	r.Equal("XMLHTTPRequest", rs.ApplyAcronyms("xmlHttpRequest"))
 }
 
 func TestPluralizeWithSize(t *testing.T) {
@@ -334,11 +540,454 @@ func TestPluralizeWithSize(t *testing.T) {
 	require.Equal(t, "plural", PluralizeWithSize("plurals", 1))
 }
 
+func TestPluralizeWithCount(t *testing.T) {
+	r := require.New(t)
+	r.Equal("0 items", PluralizeWithCount(0, "item"))
+	r.Equal("1 item", PluralizeWithCount(1, "item"))
+	r.Equal("1 item", PluralizeWithCount(1, "items"))
+	r.Equal("2 items", PluralizeWithCount(2, "item"))
+	r.Equal("2 people", PluralizeWithCount(2, "person"))
+	r.Equal("-1 items", PluralizeWithCount(-1, "item"))
+	r.Equal("-2 items", PluralizeWithCount(-2, "item"))
+}
+
+func TestHumanizeCount(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V int
+		E string
+	}{
+		{0, "0 comments"},
+		{1, "1 comment"},
+		{999, "999 comments"},
+		{1000, "1k comments"},
+		{1500, "1.5k comments"},
+		{1999, "1.9k comments"},
+		{12345, "12.3k comments"},
+		{999999, "999.9k comments"},
+		{2000000, "2M comments"},
+		{3250000, "3.2M comments"},
+		{1000000000, "1B comments"},
+		{-1500, "-1.5k comments"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, HumanizeCount(tt.V, "comment"), tt.V)
+	}
+	r.Equal("1.2k people", HumanizeCount(1200, "person"))
+}
+
+func TestPossessive(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V      string
+		Plural bool
+		E      string
+	}{
+		{"dog", false, "dog's"},
+		{"dog", true, "dogs'"},
+		{"child", false, "child's"},
+		{"child", true, "children's"},
+		{"child's", true, "children's"},
+		{"person", true, "people's"},
+		{"Woman", true, "Women's"},
+		{"sheep", true, "sheep's"},
+		{"James", false, "James's"},
+		{"boss", false, "boss's"},
+		{"boss", true, "bosses'"},
+		{"bosses'", true, "bosses'"},
+		{"BUS", true, "BUSES'"},
+		{"", true, ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Possessive(tt.V, tt.Plural), tt.V)
+	}
+
+	r.Equal("James'", PossessiveWithStyle("James", false, PossessiveApostrophe))
+	r.Equal("boss'", PossessiveWithStyle("boss", false, PossessiveApostrophe))
+	r.Equal("dog's", PossessiveWithStyle("dog", false, PossessiveApostrophe))
+	r.Equal("bosses'", PossessiveWithStyle("boss", true, PossessiveApostrophe))
+	r.Equal("James's", PossessiveWithStyle("James's", false, PossessiveApostropheS))
+}
+
+func TestPluralizeHyphenatedCompounds(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"mother-in-law", "mothers-in-law"},
+		{"Brother-in-Law", "Brothers-in-Law"},
+		{"passer-by", "passers-by"},
+		{"man-of-war", "men-of-war"},
+		{"commander-in-chief", "commanders-in-chief"},
+		{"attorney-general", "attorneys-general"},
+		{"check-in", "check-ins"},
+		{"follow-up", "follow-ups"},
+		{"e-mail", "e-mails"},
+		{"well-wisher", "well-wishers"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Pluralize(tt.V))
+		r.Equal(tt.V, Singularize(tt.E))
+	}
+	r.Equal("MOTHERs-IN-LAW", Pluralize("MOTHER-IN-LAW"))
+	r.Equal("MEN-OF-WAR", Pluralize("MAN-OF-WAR"))
+
+	rs := NewDefaultRuleset()
+	rs.AddCompound("-on")
+	r.Equal("hangers-on", rs.Pluralize("hanger-on"))
+	r.Equal("hanger-on", rs.Singularize("hangers-on"))
+	r.Equal("hanger-ons", Pluralize("hanger-on"))
+}
+
+func TestPluralizePhrase(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"attorney general", "attorneys general"},
+		{"mother-in-law", "mothers-in-law"},
+		{"passerby", "passersby"},
+		{"box of chocolate", "box of chocolates"},
+		{"user account", "user accounts"},
+		{"person", "people"},
+		{"", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, PluralizePhrase(tt.V))
+	}
+
+	rs := NewDefaultRuleset()
+	rs.AddPhrase("court martial", "courts martial")
+	r.Equal("courts martial", rs.PluralizePhrase("court martial"))
+	r.Equal("court martials", PluralizePhrase("court martial"))
+	r.Equal("courts martial", rs.Clone().PluralizePhrase("court martial"))
+}
+
+func TestPluralizeAllAndSingularizeAll(t *testing.T) {
+	r := require.New(t)
+	singulars := []string{"person", "sheep", "category", "", "box", "equipment", "Status"}
+	plurals := []string{"people", "sheep", "categories", "", "boxes", "equipment", "Statuses"}
+	r.Equal(plurals, PluralizeAll(singulars))
+	r.Equal(singulars, SingularizeAll(plurals))
+	r.Equal("person", singulars[0])
+
+	r.Nil(PluralizeAll(nil))
+	r.Nil(SingularizeAll(nil))
+	r.Equal([]string{}, PluralizeAll([]string{}))
+}
+
+func TestPluralizeAllContext(t *testing.T) {
+	r := require.New(t)
+	words := []string{"person", "box", "sheep"}
+	plurals, err := PluralizeAllContext(context.Background(), words)
+	r.NoError(err)
+	r.Equal([]string{"people", "boxes", "sheep"}, plurals)
+
+	singulars, err := SingularizeAllContext(context.Background(), plurals)
+	r.NoError(err)
+	r.Equal(words, singulars)
+
+	plurals, err = PluralizeAllContext(context.Background(), nil)
+	r.NoError(err)
+	r.Nil(plurals)
+}
+
+func TestPluralizeAllContextCancelled(t *testing.T) {
+	r := require.New(t)
+	words := make([]string, 10*contextCheckInterval)
+	for i := range words {
+		words[i] = "category"
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	plurals, err := PluralizeAllContext(ctx, words)
+	r.Equal(context.Canceled, err)
+	r.Empty(plurals)
+
+	// cancel part way through
+	ctx, cancel = context.WithCancel(context.Background())
+	rs := NewDefaultRuleset()
+	n := 0
+	rs.SetDefaultSingularFunc(func(w string) string {
+		if n++; n == contextCheckInterval+1 {
+			cancel()
+		}
+		return w
+	})
+	singulars, err := rs.SingularizeAllContext(ctx, words)
+	r.Equal(context.Canceled, err)
+	r.Len(singulars, 2*contextCheckInterval)
+	r.Equal("category", singulars[0])
+}
+
+func TestPluralizeWith(t *testing.T) {
+	r := require.New(t)
+	overrides := map[string]string{
+		"octopus": "octopuses",
+		"Person":  "persons",
+	}
+	r.Equal("octopuses", PluralizeWith("octopus", overrides))
+	r.Equal("Octopuses", PluralizeWith("Octopus", overrides))
+	r.Equal("persons", PluralizeWith("person", overrides))
+	r.Equal("Persons", PluralizeWith("Person", overrides))
+	r.Equal("children", PluralizeWith("child", overrides))
+	r.Equal("posts", PluralizeWith("post", nil))
+
+	r.Equal("octopus", SingularizeWith("octopuses", overrides))
+	r.Equal("Person", SingularizeWith("Persons", overrides))
+	r.Equal("child", SingularizeWith("children", overrides))
+	r.Equal("post", SingularizeWith("posts", nil))
+
+	// an exact key wins over one differing only in case
+	cased := map[string]string{
+		"Octopus": "Octopodes",
+		"octopus": "octopuses",
+	}
+	for i := 0; i < 20; i++ {
+		r.Equal("octopuses", PluralizeWith("octopus", cased))
+		r.Equal("Octopodes", PluralizeWith("Octopus", cased))
+		r.Equal("Octopodes", PluralizeWith("OCTOPUS", cased))
+		r.Equal("octopus", SingularizeWith("octopuses", cased))
+		r.Equal("Octopus", SingularizeWith("Octopodes", cased))
+	}
+
+	// the default ruleset is untouched
+	r.Equal("octopi", Pluralize("octopus"))
+	r.Equal("people", Pluralize("person"))
+}
+
+func TestPluralizeRule(t *testing.T) {
+	r := require.New(t)
+
+	plural, matched := PluralizeRule("index")
+	r.Equal("indices", plural)
+	r.True(matched)
+
+	plural, matched = PluralizeRule("zibbit")
+	r.Equal("zibbits", plural)
+	r.False(matched)
+
+	plural, matched = PluralizeRule("sheep")
+	r.Equal("sheep", plural)
+	r.True(matched)
+
+	singular, matched := SingularizeRule("indices")
+	r.Equal("index", singular)
+	r.True(matched)
+
+	singular, matched = SingularizeRule("zibbit")
+	r.Equal("zibbit", singular)
+	r.False(matched)
+
+	rs := NewRuleset()
+	plural, matched = rs.PluralizeRule("index")
+	r.Equal("indexs", plural)
+	r.False(matched)
+	r.Equal(rs.Pluralize("index"), plural)
+}
+
+func TestPluralizeWordsEndingInO(t *testing.T) {
+	r := require.New(t)
+	oes := map[string]string{
+		"hero":    "heroes",
+		"potato":  "potatoes",
+		"echo":    "echoes",
+		"veto":    "vetoes",
+		"tomato":  "tomatoes",
+		"Hero":    "Heroes",
+		"buffalo": "buffaloes",
+	}
+	plain := map[string]string{
+		"photo": "photos",
+		"piano": "pianos",
+		"halo":  "halos",
+		"Photo": "Photos",
+	}
+	for _, m := range []map[string]string{oes, plain} {
+		for singular, plural := range m {
+			r.Equal(plural, Pluralize(singular), singular)
+			r.Equal(singular, Singularize(plural), plural)
+		}
+	}
+}
+
+func TestPluralizeIsIdempotent(t *testing.T) {
+	r := require.New(t)
+	words := append([]string{
+		"quiz", "box", "ox", "person", "Person", "CATEGORY", "child", "matrix",
+		"status", "bus", "alias", "mouse", "wolf", "mother-in-law", "sheep",
+	}, RoundTripNouns...)
+	for _, w := range words {
+		p := Pluralize(w)
+		r.Equal(p, Pluralize(p), w)
+	}
+
+	// a ruleset without a rule keeping plurals as they are
+	rs := NewRuleset()
+	rs.AddPlural("y", "ies")
+	rs.AddSingular("ies", "y")
+	rs.AddSingular("s", "")
+	r.Equal("categories", rs.Pluralize("category"))
+	r.Equal("categories", rs.Pluralize("categories"))
+	r.Equal("dogs", rs.Pluralize("dog"))
+}
+
+func TestPluralizeSingularsEndingInS(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"thesis", "theses"},
+		{"hypothesis", "hypotheses"},
+		{"synopsis", "synopses"},
+		{"parenthesis", "parentheses"},
+		{"emphasis", "emphases"},
+		{"oasis", "oases"},
+		{"prognosis", "prognoses"},
+		{"syllabus", "syllabuses"},
+		{"genus", "genera"},
+		{"Thesis", "Theses"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Pluralize(tt.V), tt.V)
+		r.Equal(tt.E, Pluralize(tt.E), tt.E)
+	}
+	for _, w := range []string{"thesis", "hypothesis", "oasis", "emphasis", "syllabus", "genus"} {
+		r.False(IsPlural(w), w)
+		r.Equal(w, Singularize(w))
+	}
+}
+
 func TestPluralizePlurals(t *testing.T) {
 	require.Equal(t, "plurals", Pluralize("plurals"))
 	require.Equal(t, "Plurals", Pluralize("Plurals"))
 }
 
+func TestPluralizePreservesCase(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		S string
+		P string
+	}{
+		{S: "category", P: "categories"},
+		{S: "Category", P: "Categories"},
+		{S: "CATEGORY", P: "CATEGORIES"},
+		{S: "BOX", P: "BOXES"},
+		{S: "WIDGET", P: "WIDGETs"},
+		{S: "SHEEP", P: "SHEEP"},
+		{S: "API", P: "APIs"},
+		{S: "CSS", P: "CSSs"},
+		{S: "HTML", P: "HTMLs"},
+		{S: "UFO", P: "UFOs"},
+		{S: "IO", P: "IOs"},
+		{S: "X11", P: "X11s"},
+		{S: "HTTP API", P: "HTTP APIs"},
+		{S: "JSONAPI", P: "JSONAPIs"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.P, Pluralize(tt.S))
+		r.Equal(tt.S, Singularize(tt.P))
+	}
+	r.Equal("HS", Singularize("HS"))
+	r.Equal("CSS", Singularize("CSS"))
+}
+
+func TestIrregularsPreserveCapitalization(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"Person", "People"},
+		{"Child", "Children"},
+		{"PERSON", "PEOPLE"},
+		{"Man", "Men"},
+		{"person", "people"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Pluralize(tt.V))
+		r.Equal(tt.V, Singularize(tt.E))
+	}
+
+	rs := NewDefaultRuleset()
+	rs.AddIrregular("cactus", "cacti")
+	r.Equal("Cacti", rs.Pluralize("Cactus"))
+	r.Equal("Cactus", rs.Singularize("Cacti"))
+	r.Equal("Schema", rs.Singularize("Schemata"))
+}
+
+func TestExactRulesWithMultibyteCapital(t *testing.T) {
+	r := require.New(t)
+	rs := NewRuleset()
+	rs.AddPluralExact("ångström", "ångströms", true)
+	rs.AddSingularExact("ångströms", "ångström", true)
+	rs.AddPluralExact("éclair", "éclairs", true)
+	r.Equal("Ångströms", rs.Pluralize("Ångström"))
+	r.Equal("ångströms", rs.Pluralize("ångström"))
+	r.Equal("Ångström", rs.Singularize("Ångströms"))
+	r.Equal("Éclairs", rs.Pluralize("Éclair"))
+	r.Equal("Ωmega", rs.Capitalize("ωmega"))
+}
+
+func TestEmptyAndSingleRuneWords(t *testing.T) {
+	r := require.New(t)
+	for _, w := range []string{"", "s", "a", "S", "x", "é", "日"} {
+		r.Equal(w, Pluralize(w), w)
+		r.Equal(w, Singularize(w), w)
+	}
+	r.Equal("ox", Singularize("oxen"))
+}
+
+func TestEmptyAndWhitespaceInputsDoNotPanic(t *testing.T) {
+	r := require.New(t)
+	transforms := map[string]func(string) string{
+		"Pluralize":             Pluralize,
+		"PluralizePhrase":       PluralizePhrase,
+		"Singularize":           Singularize,
+		"Capitalize":            Capitalize,
+		"Camelize":              Camelize,
+		"CamelizeAcronyms":      CamelizeAcronyms,
+		"CamelizeDownFirst":     CamelizeDownFirst,
+		"PascalCase":            PascalCase,
+		"CamelCase":             CamelCase,
+		"GoName":                GoName,
+		"JSONName":              JSONName,
+		"Titleize":              Titleize,
+		"Underscore":            Underscore,
+		"SnakeCase":             SnakeCase,
+		"KebabCase":             KebabCase,
+		"ScreamingSnakeCase":    ScreamingSnakeCase,
+		"Humanize":              Humanize,
+		"HumanizeLower":         HumanizeLower,
+		"SentenceCase":          SentenceCase,
+		"ForeignKey":            ForeignKey,
+		"ForeignKeyCondensed":   ForeignKeyCondensed,
+		"ForeignKeyToAttribute": ForeignKeyToAttribute,
+		"Tableize":              Tableize,
+		"TableizeWithSchema":    TableizeWithSchema,
+		"Typeify":               Typeify,
+		"Classify":              Classify,
+		"Parameterize":          Parameterize,
+		"Dasherize":             Dasherize,
+		"Ordinalize":            Ordinalize,
+		"Asciify":               Asciify,
+		"ApplyAcronyms":         ApplyAcronyms,
+	}
+	for name, fn := range transforms {
+		for _, w := range []string{"", " ", "   ", "\t\n", "_", "-"} {
+			r.NotPanics(func() { fn(w) }, fmt.Sprintf("%s(%q)", name, w))
+		}
+	}
+	r.Equal("", Capitalize(""))
+	r.Equal("", CamelizeDownFirst(""))
+	r.Equal("", Humanize(""))
+	r.Equal("", Titleize(""))
+}
+
 func TestPluralizeEmptyString(t *testing.T) {
 	require.Equal(t, "", Pluralize(""))
 }
@@ -351,6 +1000,157 @@ func TestUncountables(t *testing.T) {
 	}
 }
 
+func TestDataAndMediaAreUncountable(t *testing.T) {
+	r := require.New(t)
+	for _, w := range []string{"data", "media"} {
+		r.Equal(w, Pluralize(w))
+		r.Equal(w, Singularize(w))
+	}
+	r.Equal("data", Pluralize("datum"))
+	r.Equal("media", Pluralize("medium"))
+}
+
+func TestAddScientificPlurals(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	rs.AddScientificPlurals()
+	r.Equal("data", rs.Pluralize("datum"))
+	r.Equal("datum", rs.Singularize("data"))
+	r.Equal("data", rs.Pluralize("data"))
+	r.Equal("media", rs.Pluralize("medium"))
+	r.Equal("medium", rs.Singularize("media"))
+	r.Equal("media", rs.Pluralize("media"))
+	r.Equal("data", Singularize("data"))
+}
+
+func TestIsUncountable(t *testing.T) {
+	r := require.New(t)
+	r.True(IsUncountable("sheep"))
+	r.True(IsUncountable("Sheep"))
+	r.True(IsUncountable("equipment"))
+	r.True(IsUncountable("school fish"))
+	r.False(IsUncountable("fish schools"))
+	r.False(IsUncountable("person"))
+	r.False(IsUncountable(""))
+
+	rs := NewRuleset()
+	r.False(rs.IsUncountable("sheep"))
+	rs.AddUncountable("sheep")
+	r.True(rs.IsUncountable("sheep"))
+}
+
+func TestIsPluralAndIsSingular(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V        string
+		Plural   bool
+		Singular bool
+	}{
+		{"people", true, false},
+		{"person", false, true},
+		{"sheep", true, true},
+		{"data", true, true},
+		{"categories", true, false},
+		{"category", false, true},
+		{"status", false, true},
+		{"statuses", true, false},
+		{"glass", false, true},
+		{"news", false, true},
+	}
+	for _, tt := range table {
+		r.Equal(tt.Plural, IsPlural(tt.V), tt.V)
+		r.Equal(tt.Singular, IsSingular(tt.V), tt.V)
+	}
+	for singular, plural := range SingularToPlural {
+		r.True(IsSingular(singular), singular)
+		if singular != plural {
+			r.True(IsPlural(plural), plural)
+		}
+	}
+}
+
+// writeTo adapts one of the *To writers to a string transform
+func writeTo(fn func(io.Writer, string) error) func(string) string {
+	return func(word string) string {
+		bb := &bytes.Buffer{}
+		if err := fn(bb, word); err != nil {
+			return err.Error()
+		}
+		return bb.String()
+	}
+}
+
+func TestPassthrough(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	rs.AddPassthrough("X11")
+	rs.AddPassthrough("C3PO")
+	r.True(rs.IsPassthrough("X11"))
+	r.False(rs.IsPassthrough("x11"))
+	r.False(IsPassthrough("X11"))
+
+	transforms := map[string]func(string) string{
+		"Pluralize":          rs.Pluralize,
+		"Singularize":        rs.Singularize,
+		"Capitalize":         rs.Capitalize,
+		"Camelize":           rs.Camelize,
+		"CamelizeAcronyms":   rs.CamelizeAcronyms,
+		"CamelizeDownFirst":  rs.CamelizeDownFirst,
+		"PascalCase":         rs.PascalCase,
+		"CamelCase":          rs.CamelCase,
+		"Titleize":           rs.Titleize,
+		"TitleizePreserving": rs.TitleizePreserving,
+		"Underscore":         rs.Underscore,
+		"SnakeCase":          rs.SnakeCase,
+		"KebabCase":          rs.KebabCase,
+		"ScreamingSnakeCase": rs.ScreamingSnakeCase,
+		"Humanize":           rs.Humanize,
+		"HumanizeLower":      rs.HumanizeLower,
+		"SentenceCase":       rs.SentenceCase,
+		"SwapCase":           rs.SwapCase,
+		"Tableize":           rs.Tableize,
+		"Typeify":            rs.Typeify,
+		"Classify":           rs.Classify,
+		"Parameterize":       rs.Parameterize,
+		"Asciify":            rs.Asciify,
+		"Dasherize":          rs.Dasherize,
+		"ApplyAcronyms":      rs.ApplyAcronyms,
+		"JSONName":           rs.JSONName,
+		"GoName":             rs.GoName,
+		"Initials":           rs.Initials,
+		"SeparateKeepCase":   func(w string) string { return rs.SeparateKeepCase(w, " ") },
+		"JSONNameSnake":      func(w string) string { return rs.JSONNameWithStyle(w, JSONSnake) },
+		"InitialsN":          func(w string) string { return rs.InitialsN(w, 1) },
+		"UnderscoreTo":       writeTo(rs.UnderscoreTo),
+		"DasherizeTo":        writeTo(rs.DasherizeTo),
+		"CamelizeTo":         writeTo(rs.CamelizeTo),
+	}
+	for name, fn := range transforms {
+		for _, w := range []string{"X11", "C3PO"} {
+			r.Equal(w, fn(w), name)
+		}
+	}
+	r.Equal("C3PO_id", rs.ForeignKey("C3PO"))
+	r.Equal("C3POid", rs.ForeignKeyCondensed("C3PO"))
+
+	plural, matched := rs.PluralizeRule("X11")
+	r.Equal("X11", plural)
+	r.True(matched)
+
+	// only the whole input is passed through
+	r.Equal("x11s", rs.Pluralize("x11"))
+	r.Equal("x11_server", rs.Underscore("X11Server"))
+	// an uncountable is still transformed by everything else
+	r.Equal("SHEEP", rs.SwapCase("sheep"))
+
+	r.True(rs.Clone().IsPassthrough("X11"))
+	b, err := json.Marshal(rs)
+	r.NoError(err)
+	loaded := NewRuleset()
+	r.NoError(json.Unmarshal(b, loaded))
+	r.True(loaded.IsPassthrough("C3PO"))
+}
+
 func TestUncountableWordIsNotGreedy(t *testing.T) {
 	uncountableWord := "ors"
 	countableWord := "sponsor"
@@ -393,6 +1193,86 @@ func TestPluralizePlural(t *testing.T) {
 	}
 }
 
+func TestSingularizeInvertsPluralize(t *testing.T) {
+	r := require.New(t)
+	for _, noun := range RoundTripNouns {
+		e := noun
+		if x, ok := RoundTripExceptions[noun]; ok {
+			e = x
+		}
+		r.Equal(e, Singularize(Pluralize(noun)), noun)
+	}
+}
+
+func TestNewDefaultRulesetBritish(t *testing.T) {
+	r := require.New(t)
+	us := NewDefaultRuleset()
+	uk := NewDefaultRulesetBritish()
+	table := []struct {
+		V  string
+		US string
+		UK string
+	}{
+		{"axes", "axis", "axe"},
+		{"maths", "math", "maths"},
+		{"moustaches", "moustach", "moustache"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.US, us.Singularize(tt.V))
+		r.Equal(tt.UK, uk.Singularize(tt.V))
+	}
+	r.Equal("aluminia", us.Pluralize("aluminium"))
+	r.Equal("aluminium", uk.Pluralize("aluminium"))
+	r.Equal("aluminum", us.Pluralize("aluminum"))
+	r.Equal("colours", uk.Pluralize("colour"))
+	r.Equal("people", uk.Pluralize("person"))
+}
+
+func TestSchemaPlurals(t *testing.T) {
+	r := require.New(t)
+	r.Equal("schemas", Pluralize("schema"))
+	r.Equal("schemas", Pluralize("schemas"))
+	r.Equal("schemata", Pluralize("schemata"))
+	r.Equal("schema", Singularize("schemas"))
+	r.Equal("schema", Singularize("schemata"))
+	r.Equal("schema", Singularize("schema"))
+}
+
+func TestRulePrecedence(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+
+	// a short suffix added later does not shadow longer ones
+	rs.AddPlural("x", "xen")
+	r.Equal("boxen", rs.Pluralize("box"))
+	r.Equal("matrices", rs.Pluralize("matrix"))
+	r.Equal("indices", rs.Pluralize("index"))
+	rs.AddSingular("s", "z")
+	r.Equal("person", rs.Singularize("people"))
+	r.Equal("category", rs.Singularize("categories"))
+
+	// exact rules beat suffix rules
+	rs = NewRuleset()
+	rs.AddPluralExact("ox", "oxen", true)
+	rs.AddPlural("ox", "oxes")
+	r.Equal("oxen", rs.Pluralize("ox"))
+	r.Equal("boxes", rs.Pluralize("box"))
+
+	// the most recent of two equally specific rules wins
+	rs.AddPlural("us", "i")
+	rs.AddPlural("us", "uses")
+	r.Equal("campuses", rs.Pluralize("campus"))
+
+	rules := NewDefaultRuleset().Plurals()
+	for i := 1; i < len(rules); i++ {
+		prev, cur := rules[i-1], rules[i]
+		r.False(!prev.Exact() && cur.Exact(), cur.Suffix())
+		if prev.Exact() == cur.Exact() {
+			r.True(len(prev.Suffix()) >= len(cur.Suffix()), cur.Suffix())
+		}
+	}
+}
+
 func TestOverwritePreviousInflectors(t *testing.T) {
 	require.Equal(t, "series", Singularize("series"))
 	AddSingular("series", "serie")
@@ -406,22 +1286,432 @@ func TestTitleize(t *testing.T) {
 	}
 }
 
+func TestTitleizeSingleLetterWords(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "a tale of two cities", E: "A Tale Of Two Cities"},
+		{V: "the y2k bug", E: "The Y2k Bug"},
+		{V: "w w w dot", E: "WWW Dot"},
+		{V: "a b c test", E: "A B C Test"},
+		{V: "plan a", E: "Plan A"},
+		{V: "x marks the spot", E: "X Marks The Spot"},
+		{V: "LOCATION", E: "L O C A T I O N"},
+		{V: "s c a t", E: "S C A T"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Titleize(tt.V))
+	}
+}
+
+func TestTitleizeWithStyle(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"a tale of two cities", "A Tale of Two Cities"},
+		{"the lord of the rings", "The Lord of the Rings"},
+		{"of mice and men", "Of Mice and Men"},
+		{"what it is made of", "What It Is Made Of"},
+		{"gone_with_the_wind", "Gone With the Wind"},
+		{"war and peace", "War and Peace"},
+		{"the", "The"},
+		{"", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, TitleizeWithStyle(tt.V, SmallWords))
+	}
+	r.Equal("A Tale Of Two Cities", TitleizeWithStyle("a tale of two cities", nil))
+	r.Equal("Lord Of the Rings", TitleizeWithStyle("lord of the rings", []string{"THE"}))
+}
+
+func TestSplitWords(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E []string
+	}{
+		{"HTTPServer2Go", []string{"http", "server2", "go"}},
+		{"snake_case", []string{"snake", "case"}},
+		{"kebab-case", []string{"kebab", "case"}},
+		{"Title Case", []string{"title", "case"}},
+		{"camelCase", []string{"camel", "case"}},
+		{"mp3Player", []string{"mp3", "player"}},
+		{"  __leading--and trailing__ ", []string{"leading", "and", "trailing"}},
+		{"", []string{}},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, SplitWords(tt.V), tt.V)
+	}
+}
+
+func TestSetSpacers(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	r.Equal("A.b.c", rs.Camelize("a.b.c"))
+
+	rs.SetSpacers('_', ' ', ':', '-', '.', '/')
+	r.Equal("ABC", rs.Camelize("a.b.c"))
+	r.Equal("pkg_name_type_name", rs.Underscore("pkg/name.TypeName"))
+	r.Equal("Pkg Name Type", rs.Titleize("pkg/name.type"))
+	r.Equal([]string{"a", "b", "c"}, rs.SplitWords("a.b/c"))
+	r.Equal("ABC", rs.Clone().Camelize("a.b.c"))
+
+	b, err := json.Marshal(rs)
+	r.NoError(err)
+	loaded := NewRuleset()
+	r.NoError(json.Unmarshal(b, loaded))
+	r.Equal("ABC", loaded.Camelize("a.b.c"))
+
+	// spacers replace the default set
+	rs.SetSpacers('.')
+	r.Equal("Some_thingElse", rs.Camelize("some_thing.else"))
+	r.Equal([]string{"some-thing", "else"}, rs.SplitWords("some-thing.else"))
+
+	rs.SetSpacers()
+	r.Equal("A.b.c", rs.Camelize("a.b.c"))
+	r.Equal("some_thing_else", rs.Underscore("some-thing else"))
+	r.Equal("A.b.c", Camelize("a.b.c"))
+}
+
+func TestTitleizeTrailingAcronym(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	rs.AddAcronym("CSS")
+	rs.AddAcronymExact("oauth", "OAuth")
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "the api", E: "The API"},
+		{V: "the API", E: "The API"},
+		{V: "learn css", E: "Learn CSS"},
+		{V: "sign in with oauth", E: "Sign In With OAuth"},
+		{V: "the api ", E: "The API"},
+		{V: "the_api", E: "The API"},
+		{V: "the api.", E: "The API."},
+		{V: "what is an api?", E: "What Is An API?"},
+		{V: "(api)", E: "(API)"},
+		{V: "the u r l", E: "The URL"},
+		{V: "plan b", E: "Plan B"},
+		{V: "go to w w w", E: "Go To WWW"},
+		{V: "the APIs", E: "The APIs"},
+		{V: "the end.", E: "The End."},
+		{V: "see the URL.", E: "See The URL."},
+		{V: "(API)", E: "(API)"},
+		{V: "use the API, then", E: "Use The API, Then"},
+		{V: "JSON's format", E: "JSON's Format"},
+		{V: "HTTP/2 server", E: "HTTP/2 Server"},
+		{V: "is it the API?", E: "Is It The API?"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, rs.Titleize(tt.V), tt.V)
+	}
+}
+
+func TestTitleizePreserving(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"iPhone", "iPhone"},
+		{"MacBook", "MacBook"},
+		{"eBay", "eBay"},
+		{"iPhone and MacBook", "iPhone And MacBook"},
+		{"buy it on eBay", "Buy It On eBay"},
+		{"hello world", "Hello World"},
+		{"éclair", "Éclair"},
+		{"", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, TitleizePreserving(tt.V), tt.V)
+	}
+}
+
+func TestTitleizeAcronymWords(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	rs.AddAcronym("HTML")
+	rs.AddAcronym("CSS")
+	table := []struct {
+		V string
+		E string
+	}{
+		{"the html and css guide", "The HTML And CSS Guide"},
+		{"an api for html", "An API For HTML"},
+		{"Html Css", "HTML CSS"},
+		{"api_client", "API Client"},
+		{"wifi and oauth", "WiFi And Oauth"},
+		{"htmlify the css", "Htmlify The CSS"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, rs.Titleize(tt.V))
+	}
+}
+
+func TestTitleizeDictionaryWordAcronyms(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"the cat and the man", "The Cat And The Man"},
+		{"post office", "Post Office"},
+		{"the api", "The API"},
+		{"the MAC address", "The MAC Address"},
+		{"ids", "IDs"},
+		{"user_ids", "User IDs"},
+		{"UserIDs", "User IDs"},
+		{"IDs", "IDs"},
+		{"https_apis", "HTTPS APIs"},
+		{"Https apis", "HTTPS APIs"},
+		{"the IDS alert", "The IDS Alert"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Titleize(tt.V))
+	}
+	r.Equal("The Post Office", Unparameterize("the-post-office", "-"))
+
+	rs := NewDefaultRuleset()
+	rs.AddAcronym("CAT")
+	r.Equal("The CAT Scan", rs.Titleize("the cat scan"))
+}
+
+func TestAddAcronyms(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	rs.AddAcronyms("HTML", "CSS", "XML")
+	r.Equal("HTML CSS And XML", rs.Titleize("html css and xml"))
+	r.Equal("html_parser", rs.Underscore("HTMLParser"))
+	rs.AddAcronyms()
+}
+
+func TestAddAcronymsFromReader(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	err := rs.AddAcronymsFromReader(strings.NewReader("HTML, CSS\nXML\n\n,SVG\r\n"))
+	r.NoError(err)
+	r.Equal("HTML CSS XML SVG", rs.Titleize("html css xml svg"))
+	r.Equal("Html", Capitalize("html"))
+
+	err = rs.AddAcronymsFromReader(errReader{})
+	r.Error(err)
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, fmt.Errorf("read failed")
+}
+
+func TestAddAcronymExact(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	rs.AddAcronymExact("OAuth", "OAuth")
+	rs.AddAcronymExact("ipv6", "IPv6")
+
+	r.Equal("oauth_token", rs.Underscore("OAuthToken"))
+	r.Equal("ipv6_address", rs.Underscore("IPv6Address"))
+	r.Equal("OAuth Token", rs.Titleize("OAuthToken"))
+	r.Equal("OAuth Token", rs.Titleize("oauth_token"))
+	r.Equal("IPv6 Address", rs.Titleize("IPv6Address"))
+	r.Equal("IPv6 Address", rs.Titleize(rs.Underscore("IPv6Address")))
+	r.Equal("OAuth", rs.Capitalize("oauth"))
+	r.Equal("IPv6", rs.Capitalize("IPV6"))
+
+	r.Equal("o_auth_token", Underscore("OAuthToken"))
+	r.Equal("O Auth Token", Titleize("OAuthToken"))
+}
+
 func TestCapitalize(t *testing.T) {
 	for lower, capitalized := range CapitalizeMixture {
 		require.Equal(t, capitalized, Capitalize(lower))
 	}
 }
 
+func TestPreserveIDCasing(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	r.Equal("ID", rs.Capitalize("id"))
+	r.Equal("ID", rs.Camelize("id"))
+	r.Equal("User ID", rs.Titleize("user_id"))
+
+	rs.SetPreserveIDCasing(true)
+	r.Equal("Id", rs.Capitalize("id"))
+	r.Equal("Id", rs.Camelize("id"))
+	r.Equal("User Id", rs.Titleize("user_id"))
+	r.Equal("API", rs.Capitalize("api"))
+	r.Equal("Id", rs.Clone().Capitalize("id"))
+
+	b, err := json.Marshal(rs)
+	r.NoError(err)
+	loaded := NewRuleset()
+	r.NoError(json.Unmarshal(b, loaded))
+	r.Equal("Id", loaded.Capitalize("id"))
+
+	rs.SetPreserveIDCasing(false)
+	r.Equal("ID", rs.Capitalize("id"))
+	r.Equal("ID", Capitalize("id"))
+}
+
+func TestCapitalizeAcronyms(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	r.Equal("API", rs.Capitalize("api"))
+	r.Equal("URL", rs.Capitalize("url"))
+	r.Equal("ID", rs.Capitalize("id"))
+	r.Equal("WiFi", rs.Capitalize("wifi"))
+	r.Equal("Gbps", rs.Capitalize("gbps"))
+	r.Equal("Html", rs.Capitalize("html"))
+	r.Equal("Product", rs.Capitalize("product"))
+
+	rs.AddAcronym("HTML")
+	r.Equal("HTML", rs.Capitalize("html"))
+}
+
 func TestCamelize(t *testing.T) {
 	for camel, underscore := range CamelToUnderscore {
 		require.Equal(t, camel, Camelize(underscore))
 	}
 }
 
+func TestCamelizeWithDigits(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "user_2fa_token", E: "User2faToken"},
+		{V: "user_2Fa_token", E: "User2FaToken"},
+		{V: "v2_api", E: "V2Api"},
+		{V: "mp3_player", E: "Mp3Player"},
+		{V: "mp3Player", E: "Mp3Player"},
+		{V: "utf8_string", E: "Utf8String"},
+		{V: "2fa", E: "2fa"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Camelize(tt.V))
+	}
+}
+
 func TestCamelizeWithLowerDowncasesTheFirstLetter(t *testing.T) {
 	require.Equal(t, "capital", CamelizeDownFirst("Capital"))
 }
 
+func TestPascalCaseAndCamelCase(t *testing.T) {
+	r := require.New(t)
+	words := []string{"dino_party", "Camel_Case", "special_guest", "id", "API", "user_2fa_token", "Capital"}
+	for camel := range CamelToUnderscore {
+		words = append(words, camel)
+	}
+	for _, w := range words {
+		r.Equal(Camelize(w), PascalCase(w))
+		r.Equal(CamelizeDownFirst(w), CamelCase(w))
+	}
+	r.Equal("DinoParty", PascalCase("dino_party"))
+	r.Equal("dinoParty", CamelCase("dino_party"))
+}
+
+func TestCamelizeAcronyms(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"http_client", "HTTPClient"},
+		{"json_parser", "JSONParser"},
+		{"user_id", "UserID"},
+		{"api_response", "APIResponse"},
+		{"api", "API"},
+		{"dino_party", "DinoParty"},
+		{"rapid_fire", "RapidFire"},
+		{"post_title", "PostTitle"},
+		{"mac_address", "MacAddress"},
+		{"cat_id", "CatID"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, CamelizeAcronyms(tt.V))
+	}
+}
+
+func TestGoName(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"2fa_token", "X2faToken"},
+		{"user-id", "UserID"},
+		{"weird!name", "WeirdName"},
+		{"field name!", "FieldName"},
+		{"class", "Class"},
+		{"http_status", "HTTPStatus"},
+		{"ram_size", "RamSize"},
+		{"post_id", "PostID"},
+		{"__private", "Private"},
+		{"café_au_lait", "CaféAuLait"},
+		{"日本", "X日本"},
+		{"!!!", ""},
+		{"", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, GoName(tt.V))
+	}
+}
+
+func TestJSONName(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V     string
+		Camel string
+		Snake string
+	}{
+		{"UserID", "userId", "user_id"},
+		{"HTTPStatus", "httpStatus", "http_status"},
+		{"ID", "id", "id"},
+		{"FirstName", "firstName", "first_name"},
+		{"already_snake", "alreadySnake", "already_snake"},
+		{"IDs", "ids", "ids"},
+		{"UserIDs", "userIds", "user_ids"},
+		{"URLs", "urls", "urls"},
+		{"", "", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.Camel, JSONName(tt.V))
+		r.Equal(tt.Camel, JSONNameWithStyle(tt.V, JSONCamel))
+		r.Equal(tt.Snake, JSONNameWithStyle(tt.V, JSONSnake))
+	}
+}
+
+func TestCamelizeDownFirstAcronyms(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"ID", "id"},
+		{"id", "id"},
+		{"API", "api"},
+		{"APIKey", "apiKey"},
+		{"HTTPServer", "httpServer"},
+		{"APIs", "apis"},
+		{"URLs", "urls"},
+		{"APIsClient", "apisClient"},
+		{"XMLHttpRequest", "xmlHttpRequest"},
+		{"user_id", "userId"},
+		{"dino_party", "dinoParty"},
+		{"iPhone", "iPhone"},
+		{"", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, CamelizeDownFirst(tt.V))
+	}
+}
+
 func TestCamelizeWithUnderscores(t *testing.T) {
 	require.Equal(t, "CamelCase", Camelize("Camel_Case"))
 }
@@ -471,6 +1761,75 @@ func TestUnderscoreAcronymSequence(t *testing.T) {
 	require.Equal(t, "html5_html_api", Underscore("HTML5HTMLAPI"))
 }
 
+func TestUnderscoreAcronymBoundaries(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "category", E: "category"},
+		{V: "scatter", E: "scatter"},
+		{V: "CATEGORY", E: "category"},
+		{V: "IDENTITY", E: "identity"},
+		{V: "POSTAL_CODE", E: "postal_code"},
+		{V: "DECIMAL", E: "decimal"},
+		{V: "MANAGER", E: "manager"},
+		{V: "APIKey", E: "api_key"},
+		{V: "HTMLAPI", E: "html_api"},
+		{V: "duplicate", E: "duplicate"},
+		{V: "Category", E: "category"},
+		{V: "HTTPRequest", E: "http_request"},
+		{V: "HTTPServer", E: "http_server"},
+		{V: "ParseJSONResponse", E: "parse_json_response"},
+		{V: "UserIDs", E: "user_ids"},
+		{V: "APIs", E: "apis"},
+		{V: "LOCATION", E: "location"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Underscore(tt.V))
+	}
+}
+
+func TestUnderscoreMixedSeparators(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "Some-Mixed_Input Value", E: "some_mixed_input_value"},
+		{V: "a--b__c", E: "a_b_c"},
+		{V: "a - b", E: "a_b"},
+		{V: "Foo:Bar", E: "foo_bar"},
+		{V: "__leading: and::trailing--", E: "leading_and_trailing"},
+		{V: "one_-_two:: three", E: "one_two_three"},
+		{V: "-_ :_-", E: ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Underscore(tt.V), tt.V)
+	}
+}
+
+func TestUnderscoreConsecutiveCapitals(t *testing.T) {
+	r := require.New(t)
+	// without registered acronyms, so only the splitting rule applies
+	rs := NewRuleset()
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "XMLParser", E: "xml_parser"},
+		{V: "HTTPServer", E: "http_server"},
+		{V: "getID", E: "get_id"},
+		{V: "parseHTTPResponse", E: "parse_http_response"},
+		{V: "ABCDef", E: "abc_def"},
+		{V: "ABC", E: "abc"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, rs.Underscore(tt.V), tt.V)
+		r.Equal(tt.E, Underscore(tt.V), tt.V)
+	}
+}
+
 func TestUnderscore(t *testing.T) {
 	for camel, underscore := range CamelToUnderscore {
 		require.Equal(t, underscore, Underscore(camel))
@@ -480,6 +1839,126 @@ func TestUnderscore(t *testing.T) {
 	}
 }
 
+func TestSnakeCase(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "parseURLToken", E: "parse_url_token"},
+		{V: "HTTPServer", E: "http_server"},
+		{V: "SomeHTMLTidy", E: "some_html_tidy"},
+		{V: "Area51Controller", E: "area51_controller"},
+		{V: "already_snake", E: "already_snake"},
+		{V: "kebab-case-word", E: "kebab_case_word"},
+		{V: "Some Spaced words", E: "some_spaced_words"},
+		{V: "mixed-kebab_snake and Spaces", E: "mixed_kebab_snake_and_spaces"},
+		{V: "__leading_and_trailing__", E: "leading_and_trailing"},
+		{V: "double--dash__under", E: "double_dash_under"},
+		{V: "ID", E: "id"},
+		{V: "", E: ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, SnakeCase(tt.V))
+	}
+}
+
+func TestKebabCase(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "SomeText", E: "some-text"},
+		{V: "parseJSON", E: "parse-json"},
+		{V: "HTTPServer", E: "http-server"},
+		{V: "already-kebab", E: "already-kebab"},
+		{V: "snake_case_word", E: "snake-case-word"},
+		{V: "-dangling-", E: "dangling"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, KebabCase(tt.V))
+	}
+}
+
+func TestScreamingSnakeCase(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "SomeConstName", E: "SOME_CONST_NAME"},
+		{V: "parseJSON", E: "PARSE_JSON"},
+		{V: "HTTPServer", E: "HTTP_SERVER"},
+		{V: "ALREADY_SCREAMING", E: "ALREADY_SCREAMING"},
+		{V: "kebab-case__word", E: "KEBAB_CASE_WORD"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, ScreamingSnakeCase(tt.V))
+	}
+}
+
+func TestSeparateKeepCase(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V   string
+		Sep string
+		E   string
+	}{
+		{"SomeHTTPText", "-", "Some-HTTP-Text"},
+		{"SomeHTTPText", "_", "Some_HTTP_Text"},
+		{"SomeText", "-", "Some-Text"},
+		{"some_text", "-", "some-text"},
+		{"Some Text", "_", "Some_Text"},
+		{"parseJSON", "_", "parse_JSON"},
+		{"", "-", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, SeparateKeepCase(tt.V, tt.Sep))
+	}
+}
+
+func TestUnderscoreCamelizeRoundTrip(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	rs.AddAcronym("IO")
+	rs.AddAcronym("SSL")
+	rs.AddAcronym("HTML5")
+	for _, id := range []string{
+		"IOError", "HTTPServer", "APIController", "UserID", "JSONAPI",
+		"SSLError", "HTML5Parser", "Base64Encoder", "ParseJSONFromIO", "Widget",
+	} {
+		r.Equal(id, rs.ApplyAcronyms(rs.Camelize(rs.Underscore(id))), id)
+	}
+	// without acronyms only the word boundaries survive
+	r.Equal("IoError", rs.Camelize(rs.Underscore("IOError")))
+	// casing that differs from the registered acronym is not kept
+	rs.AddAcronym("XML")
+	r.Equal("XMLHTTPRequest", rs.ApplyAcronyms(rs.Camelize(rs.Underscore("XMLHttpRequest"))))
+}
+
+func TestUnderscoreIsIdempotent(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "API_KEY", E: "api_key"},
+		{V: "APIKey", E: "api_key"},
+		{V: "api_key", E: "api_key"},
+		{V: "Api_Key", E: "api_key"},
+		{V: "HTTP_SERVER_URL", E: "http_server_url"},
+		{V: "HTTPServerURL", E: "http_server_url"},
+		{V: "user_ID", E: "user_id"},
+		{V: "JSON__Web_Token_", E: "json_web_token"},
+	}
+	for _, tt := range table {
+		u := Underscore(tt.V)
+		r.Equal(tt.E, u)
+		r.Equal(u, Underscore(u))
+	}
+}
+
 func TestForeignKey(t *testing.T) {
 	for klass, foreignKey := range ClassNameToForeignKeyWithUnderscore {
 		require.Equal(t, foreignKey, ForeignKey(klass))
@@ -498,6 +1977,170 @@ func TestTableize(t *testing.T) {
 	}
 }
 
+func TestTableizeWithSchema(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"public.Account", "public.accounts"},
+		{"Admin.NodeChild", "admin.node_children"},
+		{"CamelCaseModel", "camel_case_models"},
+		{"User", "users"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, TableizeWithSchema(tt.V))
+	}
+	r.Equal("public_accounts", TableizeWithSchemaJoin("public.Account", "_"))
+	r.Equal("users", TableizeWithSchemaJoin("User", "_"))
+}
+
+type superPerson struct{}
+
+type blogCategory string
+
+func TestTableizeType(t *testing.T) {
+	r := require.New(t)
+	type User struct {
+		Name string
+	}
+	var nilUser *User
+	u := &User{}
+	r.Equal("users", TableizeType(User{}))
+	r.Equal("users", TableizeType(u))
+	r.Equal("users", TableizeType(&u))
+	r.Equal("users", TableizeType(nilUser))
+	r.Equal("super_people", TableizeType(superPerson{}))
+	r.Equal("blog_categories", TableizeType(blogCategory("")))
+	r.Equal("", TableizeType(struct{ ID int }{}))
+	r.Equal("", TableizeType(&struct{}{}))
+	r.Equal("", TableizeType(nil))
+}
+
+func TestClassify(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"blog_posts", "BlogPost"},
+		{"super_people", "SuperPerson"},
+		{"public.accounts", "Account"},
+		{"node_children", "NodeChild"},
+		{"users_statuses", "UsersStatus"},
+		{"categories", "Category"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Classify(tt.V))
+	}
+	for _, model := range []string{"SuperPerson", "BlogPost", "Alias", "NodeChild", "PrimarySpokesman", "Category", "Address"} {
+		r.Equal(model, Classify(Tableize(model)))
+	}
+}
+
+func TestParameterizeNormalizesDashesAndSpaces(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"Rock \u2014 Roll", "rock-roll"},
+		{"Rock\u2014Roll", "rock-roll"},
+		{"Rock\u2013Roll", "rock-roll"},
+		{"Rock\u2010Roll", "rock-roll"},
+		{"Rock\u2212Roll", "rock-roll"},
+		{"Rock\u00A0Roll", "rock-roll"},
+		{"Rock\u202FRoll", "rock-roll"},
+		{"Rock\u2003Roll", "rock-roll"},
+		{"Rock\u200BRoll", "rock-roll"},
+		{"Rock\tand\nRoll", "rock-and-roll"},
+		{"\uFEFFRock", "rock"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Parameterize(tt.V), fmt.Sprintf("%q", tt.V))
+	}
+	r.Equal("rock_roll", ParameterizeJoin("Rock \u2014 Roll", "_"))
+}
+
+func TestParameterizeMax(t *testing.T) {
+	r := require.New(t)
+	title := "The Quick Brown Fox Jumps Over the Lazy Dog"
+	table := []struct {
+		Max int
+		E   string
+	}{
+		{100, "the-quick-brown-fox-jumps-over-the-lazy-dog"},
+		{43, "the-quick-brown-fox-jumps-over-the-lazy-dog"},
+		{42, "the-quick-brown-fox-jumps-over-the-lazy"},
+		{19, "the-quick-brown-fox"},
+		{20, "the-quick-brown-fox"},
+		{18, "the-quick-brown"},
+		{4, "the"},
+		{3, "the"},
+		{2, "th"},
+		{0, ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, ParameterizeMax(title, "-", tt.Max))
+		r.True(len(ParameterizeMax(title, "-", tt.Max)) <= tt.Max)
+	}
+	r.Equal("supercalifragi", ParameterizeMax("Supercalifragilistic words", "-", 14))
+	r.Equal("the__quick", ParameterizeMax(title, "__", 13))
+	r.Equal("the__quick", ParameterizeMax(title, "__", 11))
+	r.Equal("thequickb", ParameterizeMax(title, "", 9))
+}
+
+func TestParameterizeWithFunc(t *testing.T) {
+	r := require.New(t)
+	romaji := map[rune]string{'東': "to", '京': "kyo", '中': "zhong", '文': "wen"}
+	fn := func(c rune) string {
+		return romaji[c] + " "
+	}
+	r.Equal("", Parameterize("東京"))
+	r.Equal("hello-to-kyo", ParameterizeWithFunc("Hello 東京", "-", fn))
+	r.Equal("zhong_wen_cafe", ParameterizeWithFunc("中文 Café", "_", fn))
+	r.Equal("hello", ParameterizeWithFunc("Hello 東京", "-", nil))
+}
+
+func TestParameterizeKeepCase(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"My Page Title", "My-Page-Title"},
+		{"  Café Déjà Vu!  ", "Cafe-Deja-Vu"},
+		{"Home -- Getting Started", "Home-Getting-Started"},
+		{"already-a-Slug", "already-a-Slug"},
+		{"", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, ParameterizeKeepCase(tt.V, "-"), tt.V)
+	}
+	r.Equal("My_Page_Title", ParameterizeKeepCase("My Page Title", "_"))
+	r.Equal("my-page-title", ParameterizeJoin("My Page Title", "-"))
+}
+
+func TestUnparameterize(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V   string
+		Sep string
+		E   string
+	}{
+		{"hello-world", "-", "Hello World"},
+		{"hello_world", "_", "Hello World"},
+		{"hello+brave+new+world", "+", "Hello Brave New World"},
+		{"cafe-deja-vu", "-", "Cafe Deja Vu"},
+		{"hello-world", "", "Hello World"},
+		{"", "-", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Unparameterize(tt.V, tt.Sep), tt.V)
+	}
+	r.Equal("Hello World", Unparameterize(Parameterize("Hello, World!"), "-"))
+}
+
 func TestParameterize(t *testing.T) {
 	for str, parameterized := range StringToParameterized {
 		require.Equal(t, parameterized, Parameterize(str))
@@ -510,12 +2153,174 @@ func TestParameterizeAndNormalize(t *testing.T) {
 	}
 }
 
+func TestAsciifyIsDeterministic(t *testing.T) {
+	r := require.New(t)
+	in := "ÀÁÂÃÄÅ Æ Ç ÈÉÊË Ğ ÌÍÎÏİ Ñ ÒÓÔÕÖØ Ş ÙÚÛÜ Ý ß àáâãäå æ ç èéêë ğ ìíîïı ñ òóôõöø ş ùúûüũūŭůűų ýÿ"
+	e := Asciify(in)
+	r.Equal("AAAAAA AE C EEEE G IIIII N OOOOOO S UUUU Y ss aaaaaa ae c eeee g iiiii n oooooo s uuuuuuuuuu yy", e)
+	for i := 0; i < 100; i++ {
+		r.Equal(e, Asciify(in))
+	}
+}
+
+func TestAsciifyDecomposition(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		// Polish
+		{V: "Zażółć gęślą jaźń", E: "Zazolc gesla jazn"},
+		{V: "Łódź", E: "Lodz"},
+		// Czech
+		{V: "Příliš žluťoučký kůň", E: "Prilis zlutoucky kun"},
+		{V: "Dvořák", E: "Dvorak"},
+		// Icelandic
+		{V: "Þórsmörk", E: "Thorsmork"},
+		{V: "Garðabær", E: "Gardabaer"},
+		// Vietnamese
+		{V: "Tiếng Việt", E: "Tieng Viet"},
+		{V: "Đà Nẵng", E: "Da Nang"},
+		// special cases decomposition doesn't handle
+		{V: "Straße", E: "Strasse"},
+		{V: "Œuvre", E: "OEuvre"},
+		// non-Latin scripts are left alone
+		{V: "日本語 がぎ", E: "日本語 がぎ"},
+		{V: "한국어", E: "한국어"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Asciify(tt.V))
+	}
+}
+
 func TestParameterizeWithCustomSeparator(t *testing.T) {
 	for str, parameterized := range StringToParameterizeWithUnderscore {
 		require.Equal(t, parameterized, ParameterizeJoin(str, "_"))
 	}
 }
 
+func TestParameterizeWithMultiCharSeparator(t *testing.T) {
+	r := require.New(t)
+	for i := 0; i < 2; i++ {
+		r.Equal("donald__e__knuth", ParameterizeJoin("Donald E. Knuth", "__"))
+		r.Equal("squeeze__separators", ParameterizeJoin("Squeeze   separators", "__"))
+		r.Equal("a-b", ParameterizeJoin("a - b", "-"))
+	}
+	r.Equal("donaldeknuth", ParameterizeJoin("Donald E. Knuth", ""))
+	r.Equal("donald(e(knuth", ParameterizeJoin("Donald E. Knuth", "("))
+}
+
+func TestParameterizeWithMetacharSeparator(t *testing.T) {
+	r := require.New(t)
+	r.Equal("hello-world", ParameterizeJoin("  Hello--World  ", "-"))
+	r.Equal("hello.world", ParameterizeJoin("  Hello   World  ", "."))
+	r.Equal("donald.e.knuth", ParameterizeJoin("Donald E. Knuth", "."))
+	r.Equal("hello*world", ParameterizeJoin("Hello  World", "*"))
+	r.Equal("a*b*c", ParameterizeJoin(" a b  c ", "*"))
+}
+
+func TestParameterizeSeparatorCacheIsBounded(t *testing.T) {
+	r := require.New(t)
+	for i := 0; i < 2*maxSquashRegexps; i++ {
+		sep := fmt.Sprintf("<%d>", i)
+		r.Equal("a"+sep+"b", ParameterizeJoin("a  b", sep))
+	}
+	squashRegexps.RLock()
+	defer squashRegexps.RUnlock()
+	r.True(len(squashRegexps.m) <= maxSquashRegexps)
+}
+
+func TestNewDefaultRulesetMatchesFreshBuild(t *testing.T) {
+	r := require.New(t)
+	fresh := newDefaultRuleset()
+	rs := NewDefaultRuleset()
+	r.Equal(fresh.Plurals(), rs.Plurals())
+	r.Equal(fresh.Singulars(), rs.Singulars())
+	r.Equal(fresh.Humans(), rs.Humans())
+	r.Equal(fresh.AcronymRules(), rs.AcronymRules())
+	r.Equal(fresh.Uncountables(), rs.Uncountables())
+
+	// rulesets built from the template are independent
+	rs.AddIrregular("person", "persons")
+	r.Equal("people", NewDefaultRuleset().Pluralize("person"))
+}
+
+func TestAddIrregulars(t *testing.T) {
+	r := require.New(t)
+	pairs := map[string]string{
+		"person": "persons",
+		"kine":   "kines",
+		"foo":    "fooz",
+		"zorb":   "zorbim",
+		"bar":    "barim",
+	}
+	batch := NewDefaultRuleset()
+	batch.AddUncountable("kine")
+	batch.AddIrregulars(pairs)
+
+	one := NewDefaultRuleset()
+	one.AddUncountable("kine")
+	for _, singular := range []string{"bar", "foo", "kine", "person", "zorb"} {
+		one.AddIrregular(singular, pairs[singular])
+	}
+	r.Equal(one.Plurals(), batch.Plurals())
+	r.Equal(one.Singulars(), batch.Singulars())
+	r.Equal(one.Uncountables(), batch.Uncountables())
+
+	for singular, plural := range pairs {
+		r.Equal(plural, batch.Pluralize(singular))
+		r.Equal(singular, batch.Singularize(plural))
+	}
+	r.Equal("Zorbim", batch.Pluralize("Zorb"))
+	r.Equal("children", batch.Pluralize("child"))
+}
+
+func irregularPairs(n int) map[string]string {
+	pairs := make(map[string]string, n)
+	for i := 0; i < n; i++ {
+		w := fmt.Sprintf("word%d", i)
+		pairs[w] = w + "im"
+	}
+	return pairs
+}
+
+func BenchmarkAddIrregular(b *testing.B) {
+	pairs := irregularPairs(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rs := NewRuleset()
+		for singular, plural := range pairs {
+			rs.AddIrregular(singular, plural)
+		}
+	}
+}
+
+func BenchmarkAddIrregulars(b *testing.B) {
+	pairs := irregularPairs(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NewRuleset().AddIrregulars(pairs)
+	}
+}
+
+func BenchmarkNewDefaultRuleset(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		NewDefaultRuleset()
+	}
+}
+
+func BenchmarkNewDefaultRulesetUncached(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		newDefaultRuleset()
+	}
+}
+
+func BenchmarkParameterize(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Parameterize("Random text with *(bad)* characters")
+	}
+}
+
 func TestTypeify(t *testing.T) {
 	for klass, table := range ClassNameToTableName {
 		require.Equal(t, klass, Typeify(table))
@@ -533,23 +2338,248 @@ func TestHumanize(t *testing.T) {
 	}
 }
 
+func TestHumanizeLower(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V     string
+		Lower string
+		Upper string
+	}{
+		{"first_name", "first name", "First name"},
+		{"author_id", "author", "Author"},
+		{"employeeSalary", "employee salary", "Employee salary"},
+		{"", "", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.Lower, HumanizeLower(tt.V))
+		r.Equal(tt.Upper, Humanize(tt.V))
+	}
+
+	rs := NewDefaultRuleset()
+	rs.AddHuman("col_rpted_bugs", "reported bugs")
+	r.Equal("reported bugs", rs.HumanizeLower("col_rpted_bugs"))
+}
+
+func TestSentenceCase(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "an API response", E: "An API response"},
+		{V: "already Capitalized", E: "Already Capitalized"},
+		{V: "camelCase stays", E: "CamelCase stays"},
+		{V: "  leading whitespace", E: "  Leading whitespace"},
+		{V: "\tnew line", E: "\tNew line"},
+		{V: "élan vital", E: "Élan vital"},
+		{V: "ñandú", E: "Ñandú"},
+		{V: "   ", E: "   "},
+		{V: "", E: ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, SentenceCase(tt.V))
+	}
+}
+
+func TestSwapCase(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"Hello World", "hELLO wORLD"},
+		{"camelCase", "CAMELcASE"},
+		{"HTTPServer 2", "httpsERVER 2"},
+		{"Élan Ñandú", "éLAN ñANDÚ"},
+		{"Σωκράτης", "σΩΚΡΆΤΗΣ"},
+		{"Привет", "пРИВЕТ"},
+		{"日本 123_!", "日本 123_!"},
+		{"", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, SwapCase(tt.V))
+	}
+	r.Equal("Round Trip", SwapCase(SwapCase("Round Trip")))
+}
+
+func TestInitials(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{"John Quincy Adams", "JQA"},
+		{"big_ben_clock", "BBC"},
+		{"camelCaseWord", "CCW"},
+		{"HTTPServer", "HS"},
+		{"kebab-case", "KC"},
+		{"  spaced   out  ", "SO"},
+		{"élan vital", "ÉV"},
+		{"", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Initials(tt.V))
+	}
+
+	r.Equal("JQ", InitialsN("John Quincy Adams", 2))
+	r.Equal("JQA", InitialsN("John Quincy Adams", 5))
+	r.Equal("J", InitialsN("john", 1))
+	r.Equal("", InitialsN("John Quincy Adams", 0))
+	r.Equal("", InitialsN("John Quincy Adams", -1))
+}
+
 func TestHumanizeByString(t *testing.T) {
 	AddHuman("col_rpted_bugs", "reported bugs")
 	require.Equal(t, "90 reported bugs recently", Humanize("90 col_rpted_bugs recently"))
 }
 
+func TestHumanizeRulesMatchWholeTokens(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	rs.AddHuman("id", "identifier")
+	table := []struct {
+		V string
+		E string
+	}{
+		{"video", "Video"},
+		{"id", "Identifier"},
+		{"user id", "User identifier"},
+		{"id_card", "Identifier card"},
+		{"valid_id_or_idea", "Valid identifier or idea"},
+		{"id id", "Identifier identifier"},
+		{"ids", "Ids"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, rs.Humanize(tt.V))
+	}
+}
+
 func TestOrdinal(t *testing.T) {
 	for number, ordinalized := range OrdinalNumbers {
 		require.Equal(t, ordinalized, Ordinalize(number))
 	}
 }
 
+func TestOrdinalizeTrimsInput(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{" 21 ", "21st"},
+		{"\t42\n", "42nd"},
+		{"+3", "3rd"},
+		{" +21 ", "21st"},
+		{"-11", "-11th"},
+		{"abc", "abc"},
+		{" abc ", " abc "},
+		{"2 1", "2 1"},
+		{"", ""},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Ordinalize(tt.V))
+	}
+}
+
+func TestOrdinalizeInt(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V int
+		E string
+	}{
+		{V: 0, E: "0th"},
+		{V: 1, E: "1st"},
+		{V: 2, E: "2nd"},
+		{V: 3, E: "3rd"},
+		{V: 11, E: "11th"},
+		{V: 12, E: "12th"},
+		{V: 13, E: "13th"},
+		{V: 101, E: "101st"},
+		{V: 111, E: "111th"},
+		{V: -1, E: "-1st"},
+		{V: -11, E: "-11th"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, OrdinalizeInt(tt.V))
+	}
+}
+
+func TestOrdinalizeHTML(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V int
+		E string
+	}{
+		{1, "1<sup>st</sup>"},
+		{2, "2<sup>nd</sup>"},
+		{3, "3<sup>rd</sup>"},
+		{4, "4<sup>th</sup>"},
+		{11, "11<sup>th</sup>"},
+		{21, "21<sup>st</sup>"},
+		{-2, "-2<sup>nd</sup>"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, OrdinalizeHTML(tt.V))
+	}
+}
+
+func TestOrdinalizeBig(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "0", E: "0th"},
+		{V: "1031", E: "1031st"},
+		{V: "10000000000000000000000001", E: "10000000000000000000000001st"},
+		{V: "10000000000000000000000002", E: "10000000000000000000000002nd"},
+		{V: "10000000000000000000000003", E: "10000000000000000000000003rd"},
+		{V: "10000000000000000000000011", E: "10000000000000000000000011th"},
+		{V: "10000000000000000000000112", E: "10000000000000000000000112th"},
+		{V: "-10000000000000000000000001", E: "-10000000000000000000000001st"},
+		{V: "-10000000000000000000000013", E: "-10000000000000000000000013th"},
+		{V: "-10000000000000000000000023", E: "-10000000000000000000000023rd"},
+	}
+	for _, tt := range table {
+		n, ok := new(big.Int).SetString(tt.V, 10)
+		r.True(ok)
+		r.Equal(tt.E, OrdinalizeBig(n))
+	}
+	r.Equal("", OrdinalizeBig(nil))
+}
+
 func TestDasherize(t *testing.T) {
 	for underscored, dasherized := range UnderscoresToDashes {
 		require.Equal(t, dasherized, Dasherize(underscored))
 	}
 }
 
+func TestDasherizeAcronyms(t *testing.T) {
+	r := require.New(t)
+	plain := NewRuleset()
+	registered := NewDefaultRuleset()
+	registered.AddAcronym("JSON")
+	registered.AddAcronym("HTTP")
+	registered.AddAcronym("ID")
+	table := []struct {
+		V string
+		E string
+	}{
+		{V: "parseJSONData", E: "parse-json-data"},
+		{V: "HTTPError", E: "http-error"},
+		{V: "userID", E: "user-id"},
+		{V: "parseJsonData", E: "parse-json-data"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, Dasherize(tt.V), tt.V)
+		r.Equal(tt.E, plain.Dasherize(tt.V), tt.V)
+		r.Equal(tt.E, registered.Dasherize(tt.V), tt.V)
+	}
+	r.Equal("get-apis", Dasherize("getAPIs"))
+	r.Equal("category", Dasherize("CATEGORY"))
+	r.Equal("postal-code", Dasherize("POSTAL_CODE"))
+}
+
 func TestUnderscoreAsReverseOfDasherize(t *testing.T) {
 	for underscored := range UnderscoresToDashes {
 		require.Equal(t, underscored, Underscore(Dasherize(underscored)))
@@ -587,3 +2617,308 @@ func Test_Address(t *testing.T) {
 	require.Equal(t, "address", Singularize("addresses"))
 	require.Equal(t, "addresses", Pluralize("addresses"))
 }
+
+func Test_Ruleset_Concurrency(t *testing.T) {
+	rs := NewDefaultRuleset()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			rs.AddIrregular(fmt.Sprintf("foo%d", i), fmt.Sprintf("foos%d", i))
+			rs.AddUncountable(fmt.Sprintf("bar%d", i))
+			rs.AddAcronym(fmt.Sprintf("ACR%d", i))
+			rs.AddHuman(fmt.Sprintf("baz%d", i), "baz")
+		}(i)
+		go func() {
+			defer wg.Done()
+			rs.Pluralize("person")
+			rs.Singularize("people")
+			rs.Titleize("my_cool_URL_enabled")
+			rs.Underscore("HTMLTidyGenerator")
+			rs.Humanize("employee_id")
+			rs.Tableize("PrimarySpokesman")
+			rs.Uncountables()
+		}()
+	}
+	wg.Wait()
+
+	r := require.New(t)
+	r.Equal("people", rs.Pluralize("person"))
+	r.Equal("foos7", rs.Pluralize("foo7"))
+	r.Equal("bar7", rs.Pluralize("bar7"))
+}
+
+func Test_Ruleset_Clone(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	c := rs.Clone()
+
+	r.Equal("people", c.Pluralize("person"))
+
+	c.AddIrregular("person", "persons")
+	c.AddUncountable("sponsor")
+	c.AddAcronym("RoR")
+	r.Equal("persons", c.Pluralize("person"))
+	r.Equal("sponsor", c.Pluralize("sponsor"))
+
+	r.Equal("people", rs.Pluralize("person"))
+	r.Equal("sponsors", rs.Pluralize("sponsor"))
+	r.False(rs.isAcronym("RoR"))
+	r.NotEqual(len(rs.plurals), len(c.plurals))
+	for i := range rs.singulars {
+		r.False(rs.singulars[i] == c.singulars[len(c.singulars)-len(rs.singulars)+i])
+	}
+}
+
+func Test_Ruleset_Remove(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+
+	r.Equal("quizzes", rs.Pluralize("quiz"))
+	r.True(rs.RemovePlural("quiz"))
+	r.Equal("quizs", rs.Pluralize("quiz"))
+	r.False(rs.RemovePlural("quiz"))
+
+	rs.AddPlural("dwarf", "dwarfs")
+	r.Equal("dwarfs", rs.Pluralize("dwarf"))
+	r.True(rs.RemovePlural("dwarf"))
+	r.Equal("dwarves", rs.Pluralize("dwarf"))
+
+	rs.AddSingular("leaves", "leaf")
+	r.Equal("leaf", rs.Singularize("leaves"))
+	r.True(rs.RemoveSingular("leaves"))
+	r.Equal("leafe", rs.Singularize("leaves"))
+
+	r.Equal("sheep", rs.Pluralize("sheep"))
+	rs.RemoveUncountable("Sheep")
+	r.Equal("sheeps", rs.Pluralize("sheep"))
+
+	r.Equal("API", rs.Capitalize("api"))
+	rs.RemoveAcronym("api")
+	r.Equal("Api", rs.Capitalize("api"))
+}
+
+func Test_Ruleset_Rules(t *testing.T) {
+	r := require.New(t)
+	rs := NewRuleset()
+	rs.AddPlural("a", "as")
+	rs.AddPlural("b", "bs")
+	rs.AddPluralExact("c", "cs", true)
+
+	plurals := rs.Plurals()
+	r.Len(plurals, 3)
+	r.Equal("c", plurals[0].Suffix())
+	r.Equal("cs", plurals[0].Replacement())
+	r.True(plurals[0].Exact())
+	r.Equal("b", plurals[1].Suffix())
+	r.Equal("a", plurals[2].Suffix())
+	r.False(plurals[2].Exact())
+
+	plurals[0] = Rule{}
+	r.Equal("c", rs.Plurals()[0].Suffix())
+
+	rs.AddSingular("as", "a")
+	rs.AddHuman("col", "column")
+	rs.AddAcronym("API")
+	r.Equal("as", rs.Singulars()[0].Suffix())
+	r.Equal("column", rs.Humans()[0].Replacement())
+	r.Equal("API", rs.AcronymRules()[0].Suffix())
+}
+
+func TestBaseAcronymsAreNormalized(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	seen := map[string]bool{}
+	for _, rule := range rs.AcronymRules() {
+		k := strings.ToUpper(rule.Suffix())
+		r.False(seen[k], k)
+		seen[k] = true
+	}
+
+	r.True(rs.isAcronym("API"))
+	r.True(rs.isAcronym("api"))
+	r.True(rs.isAcronym("WiFi"))
+	r.True(rs.isAcronym("wifi"))
+	r.True(rs.isAcronym("GBPS"))
+	r.Equal("API", rs.Capitalize("api"))
+	r.Equal("WiFi", rs.Capitalize("wifi"))
+	r.Equal("Gbps", rs.Capitalize("gbps"))
+	r.Equal("WiFi", rs.Acronyms()["WiFi"])
+	r.Equal("wifi_network", rs.Underscore("WiFiNetwork"))
+	r.Equal("WiFi Network", rs.Titleize("wifi_network"))
+
+	n := len(rs.AcronymRules())
+	rs.AddAcronym("api")
+	rs.AddAcronymExact("WIFI", "Wi-Fi")
+	r.Len(rs.AcronymRules(), n)
+	r.Equal("Wi-Fi", rs.Capitalize("wifi"))
+}
+
+func TestAcronyms(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	acronyms := rs.Acronyms()
+	r.Equal("API", acronyms["API"])
+	r.Equal("HTTP", acronyms["HTTP"])
+
+	rs.AddAcronymExact("oauth", "OAuth")
+	r.Equal("OAuth", rs.Acronyms()["oauth"])
+
+	acronyms["XYZ"] = "XYZ"
+	_, ok := rs.Acronyms()["XYZ"]
+	r.False(ok)
+
+	r.Empty(NewRuleset().Acronyms())
+}
+
+func Test_Ruleset_DefaultFuncs(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	r.Equal("foobars", rs.Pluralize("foobar"))
+	r.Equal("foobar", rs.Singularize("foobar"))
+
+	rs.SetDefaultPluralFunc(func(w string) string { return w })
+	rs.SetDefaultSingularFunc(func(w string) string { return w + "_one" })
+	r.Equal("foobar", rs.Pluralize("foobar"))
+	r.Equal("people", rs.Pluralize("person"))
+	r.Equal("foobar_one", rs.Singularize("foobar"))
+	r.Equal("person", rs.Singularize("people"))
+	r.Equal("foobar", rs.Clone().Pluralize("foobar"))
+
+	rs.SetDefaultPluralFunc(nil)
+	rs.SetDefaultSingularFunc(nil)
+	r.Equal("foobars", rs.Pluralize("foobar"))
+	r.Equal("foobar", rs.Singularize("foobar"))
+}
+
+func Test_Ruleset_LoadYAML(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	err := rs.LoadYAML(strings.NewReader(`
+plurals:
+  zz: zzim
+singulars:
+  zzim: zz
+irregulars:
+  foot: feet
+uncountables:
+  - feedback
+acronyms:
+  - RoR
+`))
+	r.NoError(err)
+	r.Equal("buzzim", rs.Pluralize("buzz"))
+	r.Equal("buzz", rs.Singularize("buzzim"))
+	r.Equal("feet", rs.Pluralize("foot"))
+	r.Equal("foot", rs.Singularize("feet"))
+	r.Equal("feedback", rs.Pluralize("feedback"))
+	r.Equal("RoR", rs.Capitalize("ror"))
+
+	r.Equal("foots", Pluralize("foot"))
+}
+
+func Test_Ruleset_LoadYAML_Invalid(t *testing.T) {
+	r := require.New(t)
+	rs := NewRuleset()
+	r.Error(rs.LoadYAML(strings.NewReader("irregulars: [unclosed")))
+}
+
+func Test_Ruleset_LoadReader(t *testing.T) {
+	r := require.New(t)
+	rs := NewRuleset()
+	r.NoError(rs.LoadReader(strings.NewReader(`{"goose": "geese"}`)))
+	r.Equal("geese", rs.Pluralize("goose"))
+	r.Equal("goose", rs.Singularize("geese"))
+
+	r.Equal("gooses", Pluralize("goose"))
+	r.Equal("geese", Singularize("geese"))
+}
+
+func Test_Ruleset_Load(t *testing.T) {
+	r := require.New(t)
+	cfg := Inflections{
+		Plurals:      map[string]string{"zz": "zzim"},
+		Singulars:    map[string]string{"zzim": "zz"},
+		Irregulars:   map[string]string{"foot": "feet"},
+		Uncountables: []string{"feedback"},
+		Acronyms:     []string{"RoR"},
+	}
+
+	b, err := json.Marshal(cfg)
+	r.NoError(err)
+	var decoded Inflections
+	r.NoError(json.Unmarshal(b, &decoded))
+	r.Equal(cfg, decoded)
+
+	rs := NewDefaultRuleset()
+	rs.Load(decoded)
+	r.Equal("buzzim", rs.Pluralize("buzz"))
+	r.Equal("buzz", rs.Singularize("buzzim"))
+	r.Equal("feet", rs.Pluralize("foot"))
+	r.Equal("feedback", rs.Pluralize("feedback"))
+	r.True(rs.isAcronym("ror"))
+
+	rs = NewDefaultRuleset()
+	r.NoError(rs.LoadReader(bytes.NewReader(b)))
+	r.Equal("buzzim", rs.Pluralize("buzz"))
+	r.Equal("foot", rs.Singularize("feet"))
+	r.Equal("feedback", rs.Singularize("feedback"))
+	r.True(rs.isAcronym("ror"))
+}
+
+// replaceLastReverse is the original reverse based implementation of
+// replaceLast, kept to check the current one against
+func replaceLastReverse(s, match, repl string) string {
+	reverse := func(s string) string {
+		o := []rune(s)
+		for i, j := 0, len(o)-1; i < j; i, j = i+1, j-1 {
+			o[i], o[j] = o[j], o[i]
+		}
+		return string(o)
+	}
+	return reverse(strings.Replace(reverse(s), reverse(match), reverse(repl), 1))
+}
+
+func Test_replaceLast(t *testing.T) {
+	r := require.New(t)
+	r.Equal("person", replaceLast("person_id", "_id", ""))
+	r.Equal("ab_id_xy", replaceLast("ab_id_id", "_id", "_xy"))
+	r.Equal("señoritas", replaceLast("señorita", "", "s"))
+	r.Equal("nope", replaceLast("nope", "x", "y"))
+
+	// every string of up to four runes over a small alphabet of one, two
+	// and three byte runes, against every match of up to two runes
+	alphabet := []string{"a", "s", "é", "日"}
+	words := []string{""}
+	for i, n := 0, 0; n < 4; n++ {
+		end := len(words)
+		for ; i < end; i++ {
+			for _, c := range alphabet {
+				words = append(words, words[i]+c)
+			}
+		}
+	}
+	matches := words[:1+len(alphabet)+len(alphabet)*len(alphabet)]
+	for _, s := range words {
+		for _, match := range matches {
+			for _, repl := range []string{"", "s", "ß日"} {
+				r.Equal(replaceLastReverse(s, match, repl), replaceLast(s, match, repl), fmt.Sprintf("%q %q %q", s, match, repl))
+			}
+		}
+	}
+}
+
+func BenchmarkReplaceLast(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		replaceLast("super_important_column_name", "name", "names")
+	}
+}
+
+func BenchmarkPluralize(b *testing.B) {
+	rs := NewDefaultRuleset()
+	for i := 0; i < b.N; i++ {
+		rs.Pluralize("super_important_column_category")
+	}
+}
@@ -0,0 +1,32 @@
+package inflect
+
+import "sync"
+
+// Language is anything that can inflect words for a particular
+// language. A *Ruleset is a Language.
+type Language interface {
+	Pluralize(string) string
+	Singularize(string) string
+}
+
+var languages = struct {
+	sync.RWMutex
+	m map[string]Language
+}{m: map[string]Language{}}
+
+// Register makes a Language available by name, replacing any Language
+// already registered under that name. "en" is registered by default
+// and uses the same rules as the package level functions.
+func Register(lang string, l Language) {
+	languages.Lock()
+	defer languages.Unlock()
+	languages.m[lang] = l
+}
+
+// For returns the Language registered by name and whether one was found
+func For(lang string) (Language, bool) {
+	languages.RLock()
+	defer languages.RUnlock()
+	l, ok := languages.m[lang]
+	return l, ok
+}
@@ -0,0 +1,42 @@
+package inflect
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// spanish is a toy Language that only knows the vowel ending rule
+type spanish struct{}
+
+func (spanish) Pluralize(word string) string {
+	return word + "s"
+}
+
+func (spanish) Singularize(word string) string {
+	return strings.TrimSuffix(word, "s")
+}
+
+func TestRegisterAndFor(t *testing.T) {
+	r := require.New(t)
+
+	en, ok := For("en")
+	r.True(ok)
+	r.Equal("people", en.Pluralize("person"))
+	r.Equal("person", en.Singularize("people"))
+
+	_, ok = For("es")
+	r.False(ok)
+
+	Register("es", spanish{})
+	es, ok := For("es")
+	r.True(ok)
+	r.Equal("gatos", es.Pluralize("gato"))
+	r.Equal("gato", es.Singularize("gatos"))
+
+	Register("en-GB", NewDefaultRulesetBritish())
+	uk, ok := For("en-GB")
+	r.True(ok)
+	r.Equal("axe", uk.Singularize("axes"))
+}
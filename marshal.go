@@ -0,0 +1,112 @@
+package inflect
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// rulesetJSON is the serialized form of a Ruleset. Rules are kept in the
+// order they are applied so a decoded ruleset behaves the same.
+type rulesetJSON struct {
+	Uncountables []string          `json:"uncountables"`
+	Plurals      []ruleJSON        `json:"plurals"`
+	Singulars    []ruleJSON        `json:"singulars"`
+	Humans       []ruleJSON        `json:"humans"`
+	Acronyms     []ruleJSON        `json:"acronyms"`
+	Phrases      map[string]string `json:"phrases,omitempty"`
+	Compounds    []string          `json:"compounds,omitempty"`
+	Passthroughs []string          `json:"passthroughs,omitempty"`
+	Spacers      string            `json:"spacers,omitempty"`
+	// PreserveIDCasing is set with SetPreserveIDCasing
+	PreserveIDCasing bool `json:"preserve_id_casing,omitempty"`
+}
+
+type ruleJSON struct {
+	Suffix      string `json:"suffix"`
+	Replacement string `json:"replacement"`
+	Exact       bool   `json:"exact,omitempty"`
+	Display     string `json:"display,omitempty"`
+	Word        bool   `json:"word,omitempty"`
+	Plural      bool   `json:"plural,omitempty"`
+}
+
+func encodeRules(rules []*Rule) []ruleJSON {
+	out := make([]ruleJSON, len(rules))
+	for i, r := range rules {
+		out[i] = ruleJSON{r.suffix, r.replacement, r.exact, r.display, r.word, r.plural}
+	}
+	return out
+}
+
+func decodeRules(rules []ruleJSON) []*Rule {
+	out := make([]*Rule, len(rules))
+	for i, r := range rules {
+		out[i] = &Rule{suffix: r.Suffix, replacement: r.Replacement, exact: r.Exact, display: r.Display, word: r.Word, plural: r.Plural}
+	}
+	return out
+}
+
+// MarshalJSON encodes every rule in the ruleset, so it can be cached and
+// loaded with UnmarshalJSON instead of being rebuilt. Functions set with
+// SetDefaultPluralFunc or SetDefaultSingularFunc are not encoded.
+func (rs *Ruleset) MarshalJSON() ([]byte, error) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	j := rulesetJSON{
+		Uncountables: make([]string, 0, len(rs.uncountables)),
+		Plurals:      encodeRules(rs.plurals),
+		Singulars:    encodeRules(rs.singulars),
+		Humans:       encodeRules(rs.humans),
+		Acronyms:     encodeRules(rs.acronyms),
+		Phrases:      rs.phrases,
+		Compounds:    rs.compounds,
+		Spacers:      string(rs.spacers),
+
+		PreserveIDCasing: rs.preserveIDCasing,
+	}
+	for w := range rs.uncountables {
+		j.Uncountables = append(j.Uncountables, w)
+	}
+	sort.Strings(j.Uncountables)
+	for w := range rs.passthroughs {
+		j.Passthroughs = append(j.Passthroughs, w)
+	}
+	sort.Strings(j.Passthroughs)
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON replaces the rules in the ruleset with those encoded by
+// MarshalJSON
+func (rs *Ruleset) UnmarshalJSON(b []byte) error {
+	var j rulesetJSON
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.rulesChanged()
+	rs.uncountables = make(map[string]bool, len(j.Uncountables))
+	for _, w := range j.Uncountables {
+		rs.uncountables[w] = true
+	}
+	rs.plurals = decodeRules(j.Plurals)
+	rs.singulars = decodeRules(j.Singulars)
+	rs.humans = decodeRules(j.Humans)
+	rs.acronyms = decodeRules(j.Acronyms)
+	rs.indexAcronyms()
+	rs.phrases = make(map[string]string, len(j.Phrases))
+	for k, v := range j.Phrases {
+		rs.phrases[k] = v
+	}
+	rs.compounds = append([]string(nil), j.Compounds...)
+	rs.passthroughs = make(map[string]bool, len(j.Passthroughs))
+	for _, w := range j.Passthroughs {
+		rs.passthroughs[w] = true
+	}
+	rs.preserveIDCasing = j.PreserveIDCasing
+	rs.spacers = nil
+	if j.Spacers != "" {
+		rs.spacers = []rune(j.Spacers)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package inflect
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRulesetJSONRoundTrip(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	rs.AddHuman("col_rpted_bugs", "reported bugs")
+	rs.AddAcronymExact("oauth", "OAuth")
+	b, err := json.Marshal(rs)
+	r.NoError(err)
+
+	loaded := NewRuleset()
+	r.NoError(json.Unmarshal(b, loaded))
+
+	words := append([]string{
+		"Person", "PERSON", "ox", "Oxen", "quiz", "mother-in-law", "attorney general",
+		"HTMLParser", "api_key", "oauth_token", "col_rpted_bugs", "sheep", "",
+	}, RoundTripNouns...)
+	for _, w := range words {
+		r.Equal(rs.Pluralize(w), loaded.Pluralize(w), w)
+		r.Equal(rs.Singularize(w), loaded.Singularize(w), w)
+		r.Equal(rs.PluralizePhrase(w), loaded.PluralizePhrase(w), w)
+		r.Equal(rs.Underscore(w), loaded.Underscore(w), w)
+		r.Equal(rs.Camelize(w), loaded.Camelize(w), w)
+		r.Equal(rs.Titleize(w), loaded.Titleize(w), w)
+		r.Equal(rs.Humanize(w), loaded.Humanize(w), w)
+	}
+	r.Equal(rs.Plurals(), loaded.Plurals())
+	r.Equal(rs.AcronymRules(), loaded.AcronymRules())
+	r.Equal(rs.Uncountables(), loaded.Uncountables())
+
+	again, err := json.Marshal(loaded)
+	r.NoError(err)
+	r.Equal(string(b), string(again))
+}
+
+func TestRulesetUnmarshalJSONReplacesRules(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	r.NoError(json.Unmarshal([]byte(`{"plurals":[{"suffix":"ox","replacement":"oxen","exact":true}]}`), rs))
+	r.Equal("oxen", rs.Pluralize("ox"))
+	r.Equal("persons", rs.Pluralize("person"))
+	r.Empty(rs.Uncountables())
+
+	r.Error(json.Unmarshal([]byte(`{"plurals":"nope"}`), rs))
+	r.Equal("oxen", rs.Pluralize("ox"))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/gobuffalo/envy"
 )
@@ -151,7 +152,11 @@ func (n Name) Package() string {
 
 // Char returns first character in lower case, this is useful for methods inside a struct.
 func (n Name) Char() string {
-	return strings.ToLower(string(n[0]))
+	if n == "" {
+		return ""
+	}
+	r, _ := utf8.DecodeRuneInString(string(n))
+	return strings.ToLower(string(r))
 }
 
 func (n Name) String() string {
@@ -204,4 +204,6 @@ func Test_Name_Char(t *testing.T) {
 
 	n := Name("Foo")
 	r.Equal("f", n.Char())
+	r.Equal("", Name("").Char())
+	r.Equal("é", Name("Élan").Char())
 }
@@ -0,0 +1,41 @@
+package inflect
+
+// PluralOption changes how PluralizeWithOptions and SingularizeWithOptions
+// behave for a single call
+type PluralOption func(*pluralOptions)
+
+type pluralOptions struct {
+	ruleset *Ruleset
+}
+
+// WithPluralRuleset makes PluralizeWithOptions and SingularizeWithOptions
+// use rs instead of the default ruleset:
+// PluralizeWithOptions("person", WithPluralRuleset(rs))
+// Other transforms are called on rs directly, as in rs.Camelize(word).
+func WithPluralRuleset(rs *Ruleset) PluralOption {
+	return func(o *pluralOptions) {
+		o.ruleset = rs
+	}
+}
+
+// PluralizeWithOptions same as Pluralize using the ruleset selected by opts
+func PluralizeWithOptions(word string, opts ...PluralOption) string {
+	return rulesetFor(opts).Pluralize(word)
+}
+
+// SingularizeWithOptions same as Singularize using the ruleset selected by opts
+func SingularizeWithOptions(word string, opts ...PluralOption) string {
+	return rulesetFor(opts).Singularize(word)
+}
+
+// rulesetFor returns the ruleset selected by opts, or the default ruleset
+func rulesetFor(opts []PluralOption) *Ruleset {
+	o := pluralOptions{ruleset: defaultRuleset}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.ruleset == nil {
+		return defaultRuleset
+	}
+	return o.ruleset
+}
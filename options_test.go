@@ -0,0 +1,30 @@
+package inflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithPluralRuleset(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	rs.AddIrregular("person", "persons")
+
+	r.Equal("persons", PluralizeWithOptions("person", WithPluralRuleset(rs)))
+	r.Equal("person", SingularizeWithOptions("persons", WithPluralRuleset(rs)))
+	r.Equal("people", PluralizeWithOptions("person"))
+	r.Equal("person", SingularizeWithOptions("people"))
+
+	// the last option wins and nil falls back to the default
+	r.Equal("people", PluralizeWithOptions("person", WithPluralRuleset(rs), WithPluralRuleset(nil)))
+	r.Equal("persons", PluralizeWithOptions("person", WithPluralRuleset(nil), WithPluralRuleset(rs)))
+}
+
+func TestHelperSignatures(t *testing.T) {
+	r := require.New(t)
+	_, ok := Helpers["pluralize"].(func(string) string)
+	r.True(ok)
+	_, ok = Helpers["singularize"].(func(string) string)
+	r.True(ok)
+}
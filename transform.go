@@ -0,0 +1,45 @@
+package inflect
+
+import "fmt"
+
+// Transformer is an ordered list of string transforms, applied first to last
+type Transformer []func(string) string
+
+// Apply runs word through every transform in order
+func (t Transformer) Apply(word string) string {
+	for _, fn := range t {
+		word = fn(word)
+	}
+	return word
+}
+
+// TransformerFor builds a Transformer from the names in Helpers,
+// for configuration driven chains like "asciffy", "parameterize"
+func TransformerFor(names ...string) (Transformer, error) {
+	t := make(Transformer, 0, len(names))
+	for _, name := range names {
+		fn, ok := Helpers[name].(func(string) string)
+		if !ok {
+			return nil, fmt.Errorf("unknown string transform %q", name)
+		}
+		t = append(t, fn)
+	}
+	return t, nil
+}
+
+// IsIdempotent reports whether applying fn twice to word gives the same
+// result as applying it once, for checking transforms before using them in
+// a pipeline: IsIdempotent(Underscore, "FooBar") is true
+func IsIdempotent(fn func(string) string, word string) bool {
+	once := fn(word)
+	return fn(once) == once
+}
+
+//Pipe applies fns to word in order: Pipe("Café", Asciify, Underscore) -> "cafe"
+func (rs *Ruleset) Pipe(word string, fns ...func(string) string) string {
+	return Transformer(fns).Apply(word)
+}
+
+func Pipe(word string, fns ...func(string) string) string {
+	return defaultRuleset.Pipe(word, fns...)
+}
@@ -0,0 +1,89 @@
+package inflect
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipe(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	r.Equal("CAFE_OWNER", rs.Pipe("CaféOwner", rs.Asciify, rs.Underscore, strings.ToUpper))
+	r.Equal("CaféOwner", Pipe("CaféOwner"))
+}
+
+func TestTransformer(t *testing.T) {
+	r := require.New(t)
+	tr := Transformer{Asciify, Underscore, strings.ToUpper}
+	r.Equal("CAFE_OWNER", tr.Apply("CaféOwner"))
+	r.Equal("", Transformer{}.Apply(""))
+}
+
+func TestTransformerFor(t *testing.T) {
+	r := require.New(t)
+	tr, err := TransformerFor("asciffy", "parameterize")
+	r.NoError(err)
+	r.Equal("creme-brulee", tr.Apply("Crème Brûlée"))
+
+	_, err = TransformerFor("asciffy", "shout")
+	r.Error(err)
+
+	// takes more than one argument
+	_, err = TransformerFor("pluralize_with_size")
+	r.Error(err)
+}
+
+func TestTransformerForPluralize(t *testing.T) {
+	r := require.New(t)
+	tr, err := TransformerFor("singularize", "pluralize")
+	r.NoError(err)
+	r.Equal("people", tr.Apply("people"))
+}
+
+func TestIsIdempotent(t *testing.T) {
+	r := require.New(t)
+	r.True(IsIdempotent(Underscore, "FooBar"))
+	r.True(IsIdempotent(strings.ToUpper, "foo"))
+	r.False(IsIdempotent(func(s string) string { return s + "!" }, "foo"))
+	r.False(IsIdempotent(SwapCase, "foo"))
+}
+
+func TestSeparatingTransformsAreIdempotent(t *testing.T) {
+	r := require.New(t)
+	inputs := []string{
+		"", " ", "--", "__", "a", "A", "FooBar", "fooBar", "foo_bar", "foo-bar",
+		"Foo Bar", "HTMLParser", "XMLHttpRequest", "getID", "UserIDs", "APIs",
+		"WiFiRouter", "MoCAAdapter", "HTML5HTMLAPI", "parseJSONData",
+		"Some-Mixed_Input Value", "a--b__c", "foo::bar", "  spaced  out ",
+		"_leading", "trailing_", "mp3Player", "user_2fa_token", "X11Server",
+		"Café Déjà Vu!", "ÉCOLE normale", "straße", "İstanbul", "日本語 text",
+		"hello, world!", "100% pure", "a.b.c", "a - b", "ABC-def_GHI",
+	}
+	inputs = append(inputs, RoundTripNouns...)
+	// and some noise from the characters the transforms treat specially
+	alphabet := []rune("aAbZiIdDsS09_- :.!éÉ'ßİ")
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		word := make([]rune, rnd.Intn(12))
+		for j := range word {
+			word[j] = alphabet[rnd.Intn(len(alphabet))]
+		}
+		inputs = append(inputs, string(word))
+	}
+
+	transforms := map[string]func(string) string{
+		"Underscore":   Underscore,
+		"Dasherize":    Dasherize,
+		"Parameterize": Parameterize,
+		"SnakeCase":    SnakeCase,
+		"KebabCase":    KebabCase,
+	}
+	for name, fn := range transforms {
+		for _, word := range inputs {
+			r.True(IsIdempotent(fn, word), name, word)
+		}
+	}
+}
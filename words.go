@@ -0,0 +1,113 @@
+package inflect
+
+import "strings"
+
+var smallNumberWords = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+	"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+	"seventeen", "eighteen", "nineteen",
+}
+
+var tensWords = []string{
+	"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+}
+
+var scaleWords = []string{
+	"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+}
+
+var irregularOrdinalWords = map[string]string{
+	"one":    "first",
+	"two":    "second",
+	"three":  "third",
+	"five":   "fifth",
+	"eight":  "eighth",
+	"nine":   "ninth",
+	"twelve": "twelfth",
+}
+
+// cardinalWords spells out n, American style without "and" or commas:
+// 1234 -> "one thousand two hundred thirty-four"
+func cardinalWords(n uint64) string {
+	if n == 0 {
+		return smallNumberWords[0]
+	}
+
+	var groups []string
+	for scale := 0; n > 0; scale++ {
+		if g := n % 1000; g > 0 {
+			w := hundredsWords(g)
+			if scaleWords[scale] != "" {
+				w += " " + scaleWords[scale]
+			}
+			groups = append([]string{w}, groups...)
+		}
+		n /= 1000
+	}
+	return strings.Join(groups, " ")
+}
+
+// hundredsWords spells out 1 <= n <= 999
+func hundredsWords(n uint64) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, smallNumberWords[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		words = append(words, smallNumberWords[n])
+	case n%10 == 0:
+		words = append(words, tensWords[n/10])
+	default:
+		words = append(words, tensWords[n/10]+"-"+smallNumberWords[n%10])
+	}
+	return strings.Join(words, " ")
+}
+
+// ordinalWord turns the final cardinal word into its ordinal form:
+// "two" -> "second", "twenty" -> "twentieth", "forty-two" -> "forty-second"
+func ordinalWord(word string) string {
+	prefix := ""
+	if i := strings.LastIndex(word, "-"); i >= 0 {
+		prefix, word = word[:i+1], word[i+1:]
+	}
+	if w, ok := irregularOrdinalWords[word]; ok {
+		return prefix + w
+	}
+	if strings.HasSuffix(word, "y") {
+		return prefix + strings.TrimSuffix(word, "y") + "ieth"
+	}
+	return prefix + word + "th"
+}
+
+// NumberToWords spells out number, American style without "and" or commas:
+// 1234 -> "one thousand two hundred thirty-four", -5 -> "negative five"
+// Tens and units are joined with a hyphen as in "thirty-four".
+func (rs *Ruleset) NumberToWords(number int) string {
+	if number < 0 {
+		// -(number+1) cannot overflow, even for the smallest int
+		return "negative " + cardinalWords(uint64(-(number+1))+1)
+	}
+	return cardinalWords(uint64(number))
+}
+
+//OrdinalizeWords 42 -> "forty-second"
+// Negative numbers fall back to the numeric form of OrdinalizeInt
+func (rs *Ruleset) OrdinalizeWords(number int) string {
+	if number < 0 {
+		return rs.OrdinalizeInt(number)
+	}
+	words := strings.Split(cardinalWords(uint64(number)), " ")
+	words[len(words)-1] = ordinalWord(words[len(words)-1])
+	return strings.Join(words, " ")
+}
+
+func OrdinalizeWords(number int) string {
+	return defaultRuleset.OrdinalizeWords(number)
+}
+
+func NumberToWords(number int) string {
+	return defaultRuleset.NumberToWords(number)
+}
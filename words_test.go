@@ -0,0 +1,170 @@
+package inflect
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OrdinalizeWords(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V int
+		E string
+	}{
+		{V: 0, E: "zeroth"},
+		{V: 1, E: "first"},
+		{V: 2, E: "second"},
+		{V: 3, E: "third"},
+		{V: 4, E: "fourth"},
+		{V: 5, E: "fifth"},
+		{V: 6, E: "sixth"},
+		{V: 7, E: "seventh"},
+		{V: 8, E: "eighth"},
+		{V: 9, E: "ninth"},
+		{V: 10, E: "tenth"},
+		{V: 11, E: "eleventh"},
+		{V: 12, E: "twelfth"},
+		{V: 13, E: "thirteenth"},
+		{V: 14, E: "fourteenth"},
+		{V: 15, E: "fifteenth"},
+		{V: 16, E: "sixteenth"},
+		{V: 17, E: "seventeenth"},
+		{V: 18, E: "eighteenth"},
+		{V: 19, E: "nineteenth"},
+		{V: 20, E: "twentieth"},
+		{V: 21, E: "twenty-first"},
+		{V: 22, E: "twenty-second"},
+		{V: 23, E: "twenty-third"},
+		{V: 24, E: "twenty-fourth"},
+		{V: 25, E: "twenty-fifth"},
+		{V: 26, E: "twenty-sixth"},
+		{V: 27, E: "twenty-seventh"},
+		{V: 28, E: "twenty-eighth"},
+		{V: 29, E: "twenty-ninth"},
+		{V: 30, E: "thirtieth"},
+		{V: 31, E: "thirty-first"},
+		{V: 32, E: "thirty-second"},
+		{V: 33, E: "thirty-third"},
+		{V: 34, E: "thirty-fourth"},
+		{V: 35, E: "thirty-fifth"},
+		{V: 36, E: "thirty-sixth"},
+		{V: 37, E: "thirty-seventh"},
+		{V: 38, E: "thirty-eighth"},
+		{V: 39, E: "thirty-ninth"},
+		{V: 40, E: "fortieth"},
+		{V: 41, E: "forty-first"},
+		{V: 42, E: "forty-second"},
+		{V: 43, E: "forty-third"},
+		{V: 44, E: "forty-fourth"},
+		{V: 45, E: "forty-fifth"},
+		{V: 46, E: "forty-sixth"},
+		{V: 47, E: "forty-seventh"},
+		{V: 48, E: "forty-eighth"},
+		{V: 49, E: "forty-ninth"},
+		{V: 50, E: "fiftieth"},
+		{V: 51, E: "fifty-first"},
+		{V: 52, E: "fifty-second"},
+		{V: 53, E: "fifty-third"},
+		{V: 54, E: "fifty-fourth"},
+		{V: 55, E: "fifty-fifth"},
+		{V: 56, E: "fifty-sixth"},
+		{V: 57, E: "fifty-seventh"},
+		{V: 58, E: "fifty-eighth"},
+		{V: 59, E: "fifty-ninth"},
+		{V: 60, E: "sixtieth"},
+		{V: 61, E: "sixty-first"},
+		{V: 62, E: "sixty-second"},
+		{V: 63, E: "sixty-third"},
+		{V: 64, E: "sixty-fourth"},
+		{V: 65, E: "sixty-fifth"},
+		{V: 66, E: "sixty-sixth"},
+		{V: 67, E: "sixty-seventh"},
+		{V: 68, E: "sixty-eighth"},
+		{V: 69, E: "sixty-ninth"},
+		{V: 70, E: "seventieth"},
+		{V: 71, E: "seventy-first"},
+		{V: 72, E: "seventy-second"},
+		{V: 73, E: "seventy-third"},
+		{V: 74, E: "seventy-fourth"},
+		{V: 75, E: "seventy-fifth"},
+		{V: 76, E: "seventy-sixth"},
+		{V: 77, E: "seventy-seventh"},
+		{V: 78, E: "seventy-eighth"},
+		{V: 79, E: "seventy-ninth"},
+		{V: 80, E: "eightieth"},
+		{V: 81, E: "eighty-first"},
+		{V: 82, E: "eighty-second"},
+		{V: 83, E: "eighty-third"},
+		{V: 84, E: "eighty-fourth"},
+		{V: 85, E: "eighty-fifth"},
+		{V: 86, E: "eighty-sixth"},
+		{V: 87, E: "eighty-seventh"},
+		{V: 88, E: "eighty-eighth"},
+		{V: 89, E: "eighty-ninth"},
+		{V: 90, E: "ninetieth"},
+		{V: 91, E: "ninety-first"},
+		{V: 92, E: "ninety-second"},
+		{V: 93, E: "ninety-third"},
+		{V: 94, E: "ninety-fourth"},
+		{V: 95, E: "ninety-fifth"},
+		{V: 96, E: "ninety-sixth"},
+		{V: 97, E: "ninety-seventh"},
+		{V: 98, E: "ninety-eighth"},
+		{V: 99, E: "ninety-ninth"},
+		{V: 100, E: "one hundredth"},
+		{V: 101, E: "one hundred first"},
+		{V: 111, E: "one hundred eleventh"},
+		{V: 212, E: "two hundred twelfth"},
+		{V: 1000, E: "one thousandth"},
+		{V: 1042, E: "one thousand forty-second"},
+		{V: 2019, E: "two thousand nineteenth"},
+		{V: 3333, E: "three thousand three hundred thirty-third"},
+		{V: 1000000, E: "one millionth"},
+		{V: 21000005, E: "twenty-one million fifth"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, OrdinalizeWords(tt.V))
+	}
+}
+
+func Test_OrdinalizeWords_Negative(t *testing.T) {
+	r := require.New(t)
+	r.Equal("-1st", OrdinalizeWords(-1))
+	r.Equal("-42nd", OrdinalizeWords(-42))
+}
+
+func Test_NumberToWords(t *testing.T) {
+	r := require.New(t)
+	table := []struct {
+		V int
+		E string
+	}{
+		{V: 0, E: "zero"},
+		{V: 7, E: "seven"},
+		{V: 15, E: "fifteen"},
+		{V: 40, E: "forty"},
+		{V: 42, E: "forty-two"},
+		{V: 100, E: "one hundred"},
+		{V: 101, E: "one hundred one"},
+		{V: 999, E: "nine hundred ninety-nine"},
+		{V: 1000, E: "one thousand"},
+		{V: 1234, E: "one thousand two hundred thirty-four"},
+		{V: 10001, E: "ten thousand one"},
+		{V: 1000000, E: "one million"},
+		{V: 2500000, E: "two million five hundred thousand"},
+		{V: 1000000001, E: "one billion one"},
+		{V: 3000000000, E: "three billion"},
+		{V: -5, E: "negative five"},
+		{V: -1234, E: "negative one thousand two hundred thirty-four"},
+	}
+	for _, tt := range table {
+		r.Equal(tt.E, NumberToWords(tt.V))
+	}
+	if strconv.IntSize == 64 {
+		var min int64 = math.MinInt64
+		r.Equal("negative nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight", NumberToWords(int(min)))
+	}
+}
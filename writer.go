@@ -0,0 +1,114 @@
+package inflect
+
+import (
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// runeWriter writes runes and strings to w, remembering the first error
+type runeWriter struct {
+	w   io.Writer
+	buf [utf8.UTFMax]byte
+	err error
+}
+
+func (rw *runeWriter) writeRune(r rune) {
+	if rw.err != nil {
+		return
+	}
+	n := utf8.EncodeRune(rw.buf[:], r)
+	_, rw.err = rw.w.Write(rw.buf[:n])
+}
+
+func (rw *runeWriter) writeString(s string) {
+	if rw.err != nil {
+		return
+	}
+	_, rw.err = io.WriteString(rw.w, s)
+}
+
+//UnderscoreTo writes the same output as Underscore to w
+// without building intermediate strings
+func (rs *Ruleset) UnderscoreTo(w io.Writer, word string) error {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		_, err := io.WriteString(w, word)
+		return err
+	}
+	return rs.writeSeparatedWords(w, word, "_")
+}
+
+//DasherizeTo writes the same output as Dasherize to w
+// without building intermediate strings
+func (rs *Ruleset) DasherizeTo(w io.Writer, word string) error {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		_, err := io.WriteString(w, word)
+		return err
+	}
+	return rs.writeSeparatedWords(w, word, "-")
+}
+
+//CamelizeTo writes the same output as Camelize to w
+// without building intermediate strings
+func (rs *Ruleset) CamelizeTo(w io.Writer, word string) error {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	if rs.passthroughs[word] {
+		_, err := io.WriteString(w, word)
+		return err
+	}
+	rw := &runeWriter{w: w}
+	if rs.isAcronym(word) {
+		for _, c := range word {
+			rw.writeRune(unicode.ToUpper(c))
+		}
+		return rw.err
+	}
+	inWord := false
+	for _, c := range word {
+		spacer := rs.isSpacer(c)
+		if inWord && (unicode.IsUpper(c) || spacer) {
+			inWord = false
+		}
+		if !spacer {
+			if inWord {
+				rw.writeRune(unicode.ToLower(c))
+			} else {
+				rw.writeRune(unicode.ToUpper(c))
+			}
+			inWord = true
+		}
+	}
+	return rw.err
+}
+
+// writeSeparatedWords is the streaming version of separatedWords
+func (rs *Ruleset) writeSeparatedWords(w io.Writer, word, sep string) error {
+	word = rs.safeCaseAcronyms(word)
+	rw := &runeWriter{w: w}
+	for i, b := range rs.wordBounds(word) {
+		if i > 0 {
+			rw.writeString(sep)
+		}
+		for _, c := range word[b[0]:b[1]] {
+			rw.writeRune(unicode.ToLower(c))
+		}
+	}
+	return rw.err
+}
+
+func UnderscoreTo(w io.Writer, word string) error {
+	return defaultRuleset.UnderscoreTo(w, word)
+}
+
+func DasherizeTo(w io.Writer, word string) error {
+	return defaultRuleset.DasherizeTo(w, word)
+}
+
+func CamelizeTo(w io.Writer, word string) error {
+	return defaultRuleset.CamelizeTo(w, word)
+}
@@ -0,0 +1,80 @@
+package inflect
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var writerWords = []string{
+	"", "Product", "SpecialGuest", "ApplicationController", "Area51Controller",
+	"HTMLTidyGenerator", "FreeBsd", "street_address", "Camel_Case", "a__b",
+	"_leading", "trailing_", "some-mixed_Input Value", "HTML5HTMLAPI", "óbito",
+	"API", "id", "dino_party",
+}
+
+func Test_Ruleset_WriterMatchesString(t *testing.T) {
+	r := require.New(t)
+	rs := NewDefaultRuleset()
+	bb := &bytes.Buffer{}
+	for _, w := range writerWords {
+		bb.Reset()
+		r.NoError(rs.UnderscoreTo(bb, w))
+		r.Equal(rs.Underscore(w), bb.String(), w)
+
+		bb.Reset()
+		r.NoError(rs.DasherizeTo(bb, w))
+		r.Equal(rs.Dasherize(w), bb.String(), w)
+
+		bb.Reset()
+		r.NoError(rs.CamelizeTo(bb, w))
+		r.Equal(rs.Camelize(w), bb.String(), w)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("boom")
+}
+
+func Test_Ruleset_WriterError(t *testing.T) {
+	r := require.New(t)
+	r.Error(UnderscoreTo(failingWriter{}, "BigBen"))
+	r.Error(CamelizeTo(failingWriter{}, "big_ben"))
+	r.NoError(UnderscoreTo(failingWriter{}, ""))
+}
+
+func BenchmarkUnderscore(b *testing.B) {
+	rs := NewDefaultRuleset()
+	for i := 0; i < b.N; i++ {
+		rs.Underscore("HTMLTidyGeneratorForSomeSpecialGuest")
+	}
+}
+
+func BenchmarkUnderscoreTo(b *testing.B) {
+	rs := NewDefaultRuleset()
+	bb := &bytes.Buffer{}
+	for i := 0; i < b.N; i++ {
+		bb.Reset()
+		rs.UnderscoreTo(bb, "HTMLTidyGeneratorForSomeSpecialGuest")
+	}
+}
+
+func BenchmarkCamelize(b *testing.B) {
+	rs := NewDefaultRuleset()
+	for i := 0; i < b.N; i++ {
+		rs.Camelize("html_tidy_generator_for_some_special_guest")
+	}
+}
+
+func BenchmarkCamelizeTo(b *testing.B) {
+	rs := NewDefaultRuleset()
+	bb := &bytes.Buffer{}
+	for i := 0; i < b.N; i++ {
+		bb.Reset()
+		rs.CamelizeTo(bb, "html_tidy_generator_for_some_special_guest")
+	}
+}